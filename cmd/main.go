@@ -36,6 +36,7 @@ func main() {
 
 	go application.GRPCSrv.MustRun()
 	application.AMQPApp.SetupMessageConsumers()
+	application.Worker.Start()
 
 	stop := make(chan os.Signal, 1)
 	signal.Notify(stop, syscall.SIGTERM, syscall.SIGINT)
@@ -4,6 +4,7 @@ import (
 	"context"
 	amqpapp "github.com/arumandesu/uniclubs-posts-service/internal/app/amqp"
 	grpcapp "github.com/arumandesu/uniclubs-posts-service/internal/app/grpc"
+	workerapp "github.com/arumandesu/uniclubs-posts-service/internal/app/worker"
 	"github.com/arumandesu/uniclubs-posts-service/internal/client/club"
 	userclient "github.com/arumandesu/uniclubs-posts-service/internal/client/user"
 	"github.com/arumandesu/uniclubs-posts-service/internal/config"
@@ -11,12 +12,18 @@ import (
 	postgrpc "github.com/arumandesu/uniclubs-posts-service/internal/grpc/post"
 	"github.com/arumandesu/uniclubs-posts-service/internal/rabbitmq"
 	"github.com/arumandesu/uniclubs-posts-service/internal/services/club"
+	commentservice "github.com/arumandesu/uniclubs-posts-service/internal/services/comment"
 	"github.com/arumandesu/uniclubs-posts-service/internal/services/event/collaborator"
 	"github.com/arumandesu/uniclubs-posts-service/internal/services/event/info"
 	"github.com/arumandesu/uniclubs-posts-service/internal/services/event/management"
 	eventparticipant "github.com/arumandesu/uniclubs-posts-service/internal/services/event/participant"
+	mentionservice "github.com/arumandesu/uniclubs-posts-service/internal/services/mention"
 	postinfo "github.com/arumandesu/uniclubs-posts-service/internal/services/post/info"
 	postmanagement "github.com/arumandesu/uniclubs-posts-service/internal/services/post/management"
+	postpoll "github.com/arumandesu/uniclubs-posts-service/internal/services/post/poll"
+	reactionservice "github.com/arumandesu/uniclubs-posts-service/internal/services/reaction"
+	reportservice "github.com/arumandesu/uniclubs-posts-service/internal/services/report"
+	tagservice "github.com/arumandesu/uniclubs-posts-service/internal/services/tag"
 	"github.com/arumandesu/uniclubs-posts-service/internal/services/user"
 	"github.com/arumandesu/uniclubs-posts-service/internal/storage/mongodb"
 	"github.com/arumandesu/uniclubs-posts-service/pkg/logger"
@@ -30,7 +37,14 @@ type App struct {
 	wg      *sync.WaitGroup
 	GRPCSrv *grpcapp.App
 	AMQPApp *amqpapp.App
+	Worker  *workerapp.App
 	mongoDB *mongodb.Storage
+
+	// the services below have no gRPC transport yet, the RPCs are missing from uniclubs-protos (see README)
+	Comments  *commentservice.Service
+	Reactions *reactionservice.Service
+	Polls     *postpoll.Service
+	Reports   *reportservice.Service
 }
 
 /*
@@ -40,8 +54,10 @@ New creates a new App instance
 		- MongoDB, RabbitMQ
 		- User, Club microservice gRPC client
 		- Event, Post gRPC services
+		- Comment, Reaction, Poll, Report services, not exposed over gRPC yet
 		- gRPC server
 		- AMQP server
+		- background worker
 	 It returns a pointer to the App instance
 */
 func New(log *slog.Logger, cfg *config.Config) *App {
@@ -79,40 +95,64 @@ func New(log *slog.Logger, cfg *config.Config) *App {
 	}
 
 	userService := userservice.New(log, mongoDB)
+	mentionService := mentionservice.New(log, userClient, clubClient, rmq)
+	tagService := tagservice.New(log, mongoDB)
 	clubService := clubservice.New(log, mongoDB)
-	eventCollaboratorService := eventcollab.New(log, mongoDB, mongoDB, mongoDB, mongoDB)
+	eventCollaboratorService := eventcollab.New(log, mongoDB, mongoDB, mongoDB, mongoDB, clubClient, rmq)
 	participateService := eventparticipant.New(log, eventparticipant.NewStorage(mongoDB, userClient, clubClient, mongoDB, mongoDB))
-	eventInfoService := eventinfo.New(log, eventinfo.NewStorage(mongoDB, mongoDB, mongoDB, clubClient, mongoDB))
+	eventInfoService := eventinfo.New(log, eventinfo.NewStorage(mongoDB, mongoDB, mongoDB, clubClient, mongoDB, mongoDB, mongoDB), tagService)
+
+	eventManagementService := eventmanagement.New(log, &wg, eventmanagement.Storages{
+		EventStorage:        mongoDB,
+		ParticipantsPurger:  mongoDB,
+		BanRecordsPurger:    mongoDB,
+		InvitePurger:        mongoDB,
+		AnnouncementStorage: mongoDB,
+	}, mentionService, tagService)
 
 	// events grpc server
 	eventServices := eventgrpc.NewServices(
-		eventmanagement.New(log, &wg, eventmanagement.Storages{
-			EventStorage:       mongoDB,
-			ParticipantsPurger: mongoDB,
-			BanRecordsPurger:   mongoDB,
-			InvitePurger:       mongoDB,
-		}),
+		eventManagementService,
 		eventCollaboratorService,
 		eventCollaboratorService,
 		eventInfoService,
 		participateService,
 	)
 
+	postManagementService := postmanagement.New(log, mongoDB, clubClient, mongoDB, mentionService, tagService, cfg.Posts.MaxPinnedPerClub, cfg.Posts.RestoreWindow)
+
 	// posts grpc server
 	postServices := postgrpc.NewServices(
-		postmanagement.New(log, mongoDB, clubClient),
-		postinfo.New(log, mongoDB, clubClient),
+		postManagementService,
+		postinfo.New(log, &wg, mongoDB, clubClient, mongoDB, mongoDB, mongoDB, mongoDB, tagService),
 	)
 
+	commentService := commentservice.New(log, mongoDB, mongoDB, mongoDB, userClient, clubClient)
+	reactionService := reactionservice.New(log, mongoDB, mongoDB, mongoDB, clubClient)
+	pollService := postpoll.New(log, mongoDB, mongoDB, clubClient)
+	reportService := reportservice.New(log, mongoDB, mongoDB, mongoDB, eventManagementService, clubClient)
+
 	grpcApp := grpcapp.New(log, cfg.GRPC.Port, eventServices, postServices)
 	amqpApp := amqpapp.New(log, userService, clubService, rmq)
+	workerApp := workerapp.New(log, &wg, cfg.Worker.Interval,
+		workerapp.Job{Name: "update scheduled posts", Run: postManagementService.UpdateScheduledPosts},
+		workerapp.Job{Name: "unpin expired posts", Run: postManagementService.UnpinExpiredPosts},
+		workerapp.Job{Name: "purge deleted posts", Run: postManagementService.PurgeDeletedPosts},
+	)
+	workerApp.RunAtStartup(workerapp.Job{Name: "backfill tags", Run: tagService.BackfillTags})
 
 	return &App{
 		log:     log,
 		wg:      &wg,
 		GRPCSrv: grpcApp,
 		AMQPApp: amqpApp,
+		Worker:  workerApp,
 		mongoDB: mongoDB,
+
+		Comments:  commentService,
+		Reactions: reactionService,
+		Polls:     pollService,
+		Reports:   reportService,
 	}
 }
 
@@ -120,6 +160,7 @@ func New(log *slog.Logger, cfg *config.Config) *App {
 Stop gracefully stops the app
 
 	 It stops the following services:
+		- background worker
 		- gRPC server
 		- AMQP server
 		- MongoDB
@@ -129,6 +170,7 @@ func (a *App) Stop() {
 	const op = "app.stop"
 	log := a.log.With(slog.String("op", op))
 
+	a.Worker.Stop()
 	a.GRPCSrv.Stop()
 
 	err := a.AMQPApp.Shutdown()
@@ -136,13 +178,13 @@ func (a *App) Stop() {
 		log.Error("failed to shutdown amqp app", logger.Err(err))
 	}
 
+	// wait for background works to be completed, they still use the mongodb connection
+	a.wg.Wait()
+
 	mongoCtx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
 	defer cancel()
 	err = a.mongoDB.Close(mongoCtx)
 	if err != nil {
 		log.Error("failed to close mongodb connection", logger.Err(err))
 	}
-
-	// wait for background works to be completed
-	a.wg.Wait()
 }
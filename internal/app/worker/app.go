@@ -0,0 +1,124 @@
+package workerapp
+
+import (
+	"context"
+	"github.com/arumandesu/uniclubs-posts-service/pkg/logger"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// startupJobTimeout limits the jobs run once when the worker starts, e.g. the data backfills
+const startupJobTimeout = 30 * time.Minute
+
+// Job is a periodic background work, it must finish before the context is done
+type Job struct {
+	Name string
+	Run  func(ctx context.Context) error
+}
+
+type App struct {
+	log      *slog.Logger
+	wg       *sync.WaitGroup
+	interval time.Duration
+	jobs     []Job
+	startup  []Job
+	// ctx is the root context of the jobs, Stop cancels it
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+func New(log *slog.Logger, wg *sync.WaitGroup, interval time.Duration, jobs ...Job) *App {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &App{
+		log:      log,
+		wg:       wg,
+		interval: interval,
+		jobs:     jobs,
+		ctx:      ctx,
+		cancel:   cancel,
+	}
+}
+
+// RunAtStartup adds the jobs which are run once in the background when the worker starts
+func (a *App) RunAtStartup(jobs ...Job) {
+	a.startup = append(a.startup, jobs...)
+}
+
+// Start runs the startup jobs once and the jobs every interval in the background until Stop is called
+func (a *App) Start() {
+	const op = "app.worker.start"
+	log := a.log.With(slog.String("op", op))
+
+	log.Info("worker is running", slog.Duration("interval", a.interval))
+
+	if len(a.startup) > 0 {
+		a.wg.Add(1)
+		go func() {
+			defer a.wg.Done()
+			a.runStartupJobs()
+		}()
+	}
+
+	a.wg.Add(1)
+	go func() {
+		defer a.wg.Done()
+
+		ticker := time.NewTicker(a.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-a.ctx.Done():
+				return
+			case <-ticker.C:
+				a.runJobs()
+			}
+		}
+	}()
+}
+
+func (a *App) runJobs() {
+	const op = "app.worker.runJobs"
+	log := a.log.With(slog.String("op", op))
+
+	for _, job := range a.jobs {
+		if a.ctx.Err() != nil {
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(a.ctx, a.interval)
+		err := job.Run(ctx)
+		cancel()
+		if err != nil {
+			log.Error("job failed", slog.String("job", job.Name), logger.Err(err))
+		}
+	}
+}
+
+// runStartupJobs runs the startup jobs one by one, they are canceled when the worker is stopped
+func (a *App) runStartupJobs() {
+	const op = "app.worker.runStartupJobs"
+	log := a.log.With(slog.String("op", op))
+
+	ctx, cancel := context.WithTimeout(a.ctx, startupJobTimeout)
+	defer cancel()
+
+	for _, job := range a.startup {
+		if ctx.Err() != nil {
+			return
+		}
+
+		if err := job.Run(ctx); err != nil {
+			log.Error("startup job failed", slog.String("job", job.Name), logger.Err(err))
+		}
+	}
+}
+
+// Stop cancels the running jobs and stops the worker, the caller waits for the jobs with the shared WaitGroup
+func (a *App) Stop() {
+	const op = "app.worker.stop"
+
+	a.log.With(slog.String("op", op)).Info("stopping worker")
+	a.cancel()
+}
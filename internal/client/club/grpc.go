@@ -131,6 +131,34 @@ func (c *Client) GetClubById(ctx context.Context, clubId int64) (*domain.Club, e
 	}, nil
 }
 
+// GetUserClubs returns the clubs the user is a member of
+func (c *Client) GetUserClubs(ctx context.Context, userId int64) ([]domain.Club, error) {
+	const op = "client.club.getUserClubs"
+	log := c.log.With(slog.String("op", op))
+
+	res, err := c.ClubClient.GetUserClubs(ctx, &clubv1.GetUserClubsRequest{UserId: userId})
+	if err != nil {
+		switch {
+		case codes.InvalidArgument == status.Code(err):
+			return nil, ErrInvalidArg
+		default:
+			log.Error("internal", logger.Err(err))
+			return nil, err
+		}
+	}
+
+	clubs := make([]domain.Club, 0, len(res.GetClubs()))
+	for _, club := range res.GetClubs() {
+		clubs = append(clubs, domain.Club{
+			ID:      club.GetClubId(),
+			Name:    club.GetName(),
+			LogoURL: club.GetLogoUrl(),
+		})
+	}
+
+	return clubs, nil
+}
+
 func New(
 	log *slog.Logger,
 	addr string,
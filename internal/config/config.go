@@ -13,6 +13,8 @@ type Config struct {
 	GRPC     GRPC     `yaml:"grpc"`
 	Rabbitmq Rabbitmq `yaml:"rabbitmq"`
 	MongoDB  MongoDB  `yaml:"mongodb"`
+	Worker   Worker   `yaml:"worker"`
+	Posts    Posts    `yaml:"posts"`
 	Clients  ClientsConfig
 }
 
@@ -34,6 +36,15 @@ type Rabbitmq struct {
 	Port     string `yaml:"port" env:"RABBITMQ_PORT"`
 }
 
+type Worker struct {
+	Interval time.Duration `yaml:"interval" env:"WORKER_INTERVAL" env-default:"1m"`
+}
+
+type Posts struct {
+	MaxPinnedPerClub int           `yaml:"max_pinned_per_club" env:"POSTS_MAX_PINNED_PER_CLUB" env-default:"3"`
+	RestoreWindow    time.Duration `yaml:"restore_window" env:"POSTS_RESTORE_WINDOW" env-default:"720h"`
+}
+
 type ClientsConfig struct {
 	User struct {
 		Address      string        `yaml:"address" env:"USER_SERVICE_ADDRESS"`
@@ -1,7 +1,7 @@
 package domain
 
 import (
-	eventv1 "github.com/ARUMANDESU/uniclubs-protos/gen/go/posts/event"
+	posts "github.com/ARUMANDESU/uniclubs-protos/gen/go/posts"
 	"github.com/stretchr/testify/assert"
 	"testing"
 )
@@ -44,7 +44,7 @@ func TestClubsToProto(t *testing.T) {
 }
 
 func TestClubFromProto(t *testing.T) {
-	protoClub := &eventv1.ClubObject{
+	protoClub := &posts.ClubObject{
 		Id:      1,
 		Name:    "Test Club",
 		LogoUrl: "http://example.com/logo.jpg",
@@ -0,0 +1,82 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const MaxCommentBodyLength = 2000
+
+type Comment struct {
+	ID           string     `json:"id"`
+	TargetType   TargetType `json:"target_type"`
+	TargetId     string     `json:"target_id"`
+	ParentId     string     `json:"parent_id,omitempty"`
+	User         User       `json:"user"`
+	Body         string     `json:"body"`
+	RepliesCount int64      `json:"replies_count"`
+	IsEdited     bool       `json:"is_edited"`
+	IsDeleted    bool       `json:"is_deleted"`
+	DeletedBy    int64      `json:"deleted_by,omitempty"`
+	Hidden       bool       `json:"hidden"`
+	HiddenBy     int64      `json:"hidden_by,omitempty"`
+	CreatedAt    time.Time  `json:"created_at"`
+	UpdatedAt    time.Time  `json:"updated_at"`
+	DeletedAt    time.Time  `json:"deleted_at"`
+}
+
+func (c *Comment) IsAuthor(userId int64) bool {
+	return c.User.ID == userId
+}
+
+func (c *Comment) IsReply() bool {
+	return c.ParentId != ""
+}
+
+func (c *Comment) Edit(body string) error {
+	if c.IsDeleted {
+		return ErrCommentDeleted
+	}
+	if err := ValidateCommentBody(body); err != nil {
+		return err
+	}
+
+	c.Body = body
+	c.IsEdited = true
+	return nil
+}
+
+// SoftDelete clears the comment body but keeps the comment, so the replies thread stays consistent
+func (c *Comment) SoftDelete(byWhoId int64) error {
+	if c.IsDeleted {
+		return ErrCommentDeleted
+	}
+
+	c.Body = ""
+	c.IsDeleted = true
+	c.DeletedBy = byWhoId
+	c.DeletedAt = time.Now()
+	return nil
+}
+
+func (c *Comment) Hide(byWhoId int64) {
+	c.Hidden = true
+	c.HiddenBy = byWhoId
+}
+
+func (c *Comment) Unhide() {
+	c.Hidden = false
+	c.HiddenBy = 0
+}
+
+func ValidateCommentBody(body string) error {
+	if strings.TrimSpace(body) == "" {
+		return fmt.Errorf("%w: body is empty", ErrInvalidCommentBody)
+	}
+	if utf8.RuneCountInString(body) > MaxCommentBodyLength {
+		return fmt.Errorf("%w: body is longer than %d characters", ErrInvalidCommentBody, MaxCommentBodyLength)
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestComment_Edit(t *testing.T) {
+	comment := Comment{User: User{ID: 1}, Body: "old"}
+
+	err := comment.Edit("new")
+	assert.NoError(t, err)
+	assert.Equal(t, "new", comment.Body)
+	assert.True(t, comment.IsEdited)
+
+	err = comment.Edit("   ")
+	assert.ErrorIs(t, err, ErrInvalidCommentBody)
+	assert.Equal(t, "new", comment.Body)
+
+	err = comment.Edit(strings.Repeat("a", MaxCommentBodyLength+1))
+	assert.ErrorIs(t, err, ErrInvalidCommentBody)
+}
+
+func TestComment_SoftDelete(t *testing.T) {
+	comment := Comment{User: User{ID: 1}, Body: "body"}
+
+	err := comment.SoftDelete(2)
+	assert.NoError(t, err)
+	assert.True(t, comment.IsDeleted)
+	assert.Empty(t, comment.Body)
+	assert.Equal(t, int64(2), comment.DeletedBy)
+	assert.False(t, comment.DeletedAt.IsZero())
+
+	assert.ErrorIs(t, comment.SoftDelete(2), ErrCommentDeleted)
+	assert.ErrorIs(t, comment.Edit("new body"), ErrCommentDeleted)
+}
+
+func TestComment_HideUnhide(t *testing.T) {
+	comment := Comment{}
+
+	comment.Hide(3)
+	assert.True(t, comment.Hidden)
+	assert.Equal(t, int64(3), comment.HiddenBy)
+
+	comment.Unhide()
+	assert.False(t, comment.Hidden)
+	assert.Zero(t, comment.HiddenBy)
+}
@@ -0,0 +1,35 @@
+package dtos
+
+import (
+	"github.com/arumandesu/uniclubs-posts-service/internal/domain"
+)
+
+type CreateComment struct {
+	TargetType domain.TargetType `json:"target_type"`
+	TargetId   string            `json:"target_id"`
+	ParentId   string            `json:"parent_id"`
+	UserId     int64             `json:"user_id"`
+	Body       string            `json:"body"`
+}
+
+type UpdateComment struct {
+	CommentId string `json:"comment_id"`
+	UserId    int64  `json:"user_id"`
+	Body      string `json:"body"`
+}
+
+// CommentAction is used for the actions which need only the comment and the acting user: delete, hide and unhide
+type CommentAction struct {
+	CommentId string `json:"comment_id"`
+	UserId    int64  `json:"user_id"`
+}
+
+type ListComments struct {
+	TargetType domain.TargetType `json:"target_type"`
+	TargetId   string            `json:"target_id"`
+	// ParentId is empty for the top level comments, otherwise the replies of the parent comment are listed
+	ParentId      string            `json:"parent_id"`
+	UserId        int64             `json:"user_id"`
+	Filter        domain.BaseFilter `json:"filter"`
+	IncludeHidden bool              `json:"-"`
+}
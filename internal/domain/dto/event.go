@@ -24,6 +24,7 @@ type UpdateEvent struct {
 	AttachedImages        []domain.File       `json:"attached_images"`
 	AttachedFiles         []domain.File       `json:"attached_files"`
 	IsHiddenForNonMembers bool                `json:"is_hidden_for_non_members"`
+	AutoAnnounce          bool                `json:"auto_announce"`
 	Paths                 map[string]bool
 }
 
@@ -31,13 +32,22 @@ type GetEvent struct {
 	Event             domain.Event             `json:"event"`
 	UserStatus        domain.UserStatus        `json:"user_status"`
 	ParticipantStatus domain.ParticipantStatus `json:"participant_status"`
+	UserReactions     []domain.ReactionType    `json:"user_reactions,omitempty"`
 }
 
 type SendJoinRequestToUser struct {
-	EventId      string      `json:"event_id"`
-	UserId       int64       `json:"user_id"`
-	Target       domain.User `json:"target"`
-	TargetClubId int64       `json:"target_club_id"`
+	EventId      string               `json:"event_id"`
+	UserId       int64                `json:"user_id"`
+	Target       domain.User          `json:"target"`
+	TargetClubId int64                `json:"target_club_id"`
+	Role         domain.OrganizerRole `json:"role"`
+}
+
+type ChangeOrganizerRole struct {
+	EventId     string               `json:"event_id"`
+	UserId      int64                `json:"user_id"`
+	OrganizerId int64                `json:"organizer_id"`
+	Role        domain.OrganizerRole `json:"role"`
 }
 
 type SendJoinRequestToClub struct {
@@ -52,6 +62,12 @@ type AcceptJoinRequestClub struct {
 	User     domain.User `json:"user"`
 }
 
+type LeaveEvent struct {
+	EventId string `json:"event_id"`
+	UserId  int64  `json:"user_id"`
+	ClubId  int64  `json:"club_id"`
+}
+
 type RejectEvent struct {
 	EventId string      `json:"event_id"`
 	User    domain.User `json:"user"`
@@ -64,6 +80,19 @@ type DeleteEvent struct {
 	IsAdmin bool   `json:"is_admin"`
 }
 
+// GetRecommendedEvents requests the event feed ranked for the user, Limit is capped by domain.MaxRecommendedEvents
+type GetRecommendedEvents struct {
+	UserId int64 `json:"user_id"`
+	Limit  int   `json:"limit"`
+}
+
+type TransferOwnership struct {
+	EventId    string `json:"event_id"`
+	UserId     int64  `json:"user_id"`
+	NewOwnerId int64  `json:"new_owner_id"`
+	IsAdmin    bool   `json:"is_admin"`
+}
+
 type GetInvites struct {
 	EventId string
 	UserId  int64
@@ -93,6 +122,7 @@ func (u *UpdateEvent) HasUnchangeableFields() bool {
 		"start_date":                true,
 		"end_date":                  true,
 		"is_hidden_for_non_members": true,
+		"auto_announce":             true,
 	}
 
 	if len(u.Paths) == 0 {
@@ -133,18 +163,13 @@ func UpdateToDTO(event *eventv1.UpdateEventRequest) (*UpdateEvent, error) {
 		}
 	}
 
-	tags := event.GetTags()
-	for i, tag := range tags {
-		tags[i] = strings.TrimSpace(tag)
-	}
-
 	return &UpdateEvent{
 		EventId:               event.GetEventId(),
 		UserId:                event.GetUserId(),
 		Title:                 strings.Trim(event.GetTitle(), " "),
 		Description:           strings.Trim(event.GetDescription(), " "),
 		Type:                  domain.EventType(event.GetType()),
-		Tags:                  tags,
+		Tags:                  domain.NormalizeTags(event.GetTags()),
 		MaxParticipants:       uint32(event.GetMaxParticipants()),
 		LocationLink:          event.GetLocationLink(),
 		LocationUniversity:    event.GetLocationUniversity(),
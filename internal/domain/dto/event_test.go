@@ -1,6 +1,7 @@
 package dtos
 
 import (
+	posts "github.com/ARUMANDESU/uniclubs-protos/gen/go/posts"
 	eventv1 "github.com/ARUMANDESU/uniclubs-protos/gen/go/posts/event"
 	"github.com/arumandesu/uniclubs-posts-service/internal/domain"
 	"github.com/stretchr/testify/assert"
@@ -13,8 +14,8 @@ import (
 
 func TestUpdateToDTO(t *testing.T) {
 
-	startDate, _ := time.Parse(domain.TimeLayout, "2024-05-12 15:04:05.999999999 -0700 MST")
-	endDate, _ := time.Parse(domain.TimeLayout, "2024-06-02 15:04:05.999999999 -0700 MST")
+	startDate, _ := time.Parse(domain.TimeLayout, "2024-05-12T15:04")
+	endDate, _ := time.Parse(domain.TimeLayout, "2024-06-02T15:04")
 	paths := []string{
 		"title", "description", "type",
 		"tags", "max_participants", "location_link",
@@ -36,11 +37,11 @@ func TestUpdateToDTO(t *testing.T) {
 		MaxParticipants:       1,
 		LocationLink:          "http://example.com/location",
 		LocationUniversity:    "Test University",
-		StartDate:             "2024-05-12 15:04:05.999999999 -0700 MST",
-		EndDate:               "2024-06-02 15:04:05.999999999 -0700 MST",
-		CoverImages:           []*eventv1.CoverImage{{Name: "Test Cover Image", Url: "http://example.com/cover.jpg", Type: "jpg", Position: 1}},
-		AttachedImages:        []*eventv1.FileObject{{Name: "Test Attached Image", Url: "http://example.com/image.jpg", Type: "jpg"}},
-		AttachedFiles:         []*eventv1.FileObject{{Name: "Test Attached File", Url: "http://example.com/file.pdf", Type: "pdf"}},
+		StartDate:             "2024-05-12T15:04",
+		EndDate:               "2024-06-02T15:04",
+		CoverImages:           []*posts.CoverImage{{Name: "Test Cover Image", Url: "http://example.com/cover.jpg", Type: "jpg", Position: 1}},
+		AttachedImages:        []*posts.FileObject{{Name: "Test Attached Image", Url: "http://example.com/image.jpg", Type: "jpg"}},
+		AttachedFiles:         []*posts.FileObject{{Name: "Test Attached File", Url: "http://example.com/file.pdf", Type: "pdf"}},
 		IsHiddenForNonMembers: false,
 		UpdateMask:            &fieldmaskpb.FieldMask{Paths: paths},
 	}
@@ -51,7 +52,7 @@ func TestUpdateToDTO(t *testing.T) {
 		Title:              "Test Title",
 		Description:        "Test Description",
 		Type:               "Test Type",
-		Tags:               []string{"Test Tag"},
+		Tags:               []string{"test-tag"},
 		MaxParticipants:    1,
 		LocationLink:       "http://example.com/location",
 		LocationUniversity: "Test University",
@@ -138,7 +139,7 @@ func TestAddCollaboratorRequestToClubToDTO(t *testing.T) {
 	event := &eventv1.AddCollaboratorRequest{
 		EventId: "1",
 		UserId:  1,
-		Club:    &eventv1.ClubObject{Id: 1, Name: "Test Club", LogoUrl: "http://example.com/logo.jpg"},
+		Club:    &posts.ClubObject{Id: 1, Name: "Test Club", LogoUrl: "http://example.com/logo.jpg"},
 	}
 
 	dto := AddCollaboratorRequestToClubToDTO(event)
@@ -3,28 +3,63 @@ package dtos
 import (
 	postv1 "github.com/ARUMANDESU/uniclubs-protos/gen/go/posts/post"
 	"github.com/arumandesu/uniclubs-posts-service/internal/domain"
+	"time"
 )
 
 type CreatePostRequest struct {
-	ClubId        int64               `json:"club_id"`
-	UserId        int64               `json:"user_id"`
-	Title         string              `json:"title"`
-	Description   string              `json:"description"`
-	Tags          []string            `json:"tags"`
-	CoverImages   []domain.CoverImage `json:"cover_images"`
-	AttachedFiles []domain.File       `json:"attached_files"`
-	Paths         map[string]bool     `json:"paths"`
+	ClubId        int64                 `json:"club_id"`
+	UserId        int64                 `json:"user_id"`
+	Title         string                `json:"title"`
+	Description   string                `json:"description"`
+	Tags          []string              `json:"tags"`
+	CoverImages   []domain.CoverImage   `json:"cover_images"`
+	AttachedFiles []domain.File         `json:"attached_files"`
+	Status        domain.PostStatus     `json:"status"`
+	PublishAt     time.Time             `json:"publish_at"`
+	ExpiresAt     time.Time             `json:"expires_at"`
+	Poll          *domain.Poll          `json:"poll"`
+	EventIds      []string              `json:"event_ids"`
+	Visibility    domain.PostVisibility `json:"visibility"`
+	Paths         map[string]bool       `json:"paths"`
 }
 
 type UpdatePostRequest struct {
-	PostId        string              `json:"post_id"`
-	UserId        int64               `json:"user_id"`
-	Title         string              `json:"title"`
-	Description   string              `json:"description"`
-	Tags          []string            `json:"tags"`
-	CoverImages   []domain.CoverImage `json:"cover_images"`
-	AttachedFiles []domain.File       `json:"attached_files"`
-	Paths         map[string]bool     `json:"paths"`
+	PostId        string                `json:"post_id"`
+	UserId        int64                 `json:"user_id"`
+	Title         string                `json:"title"`
+	Description   string                `json:"description"`
+	Tags          []string              `json:"tags"`
+	CoverImages   []domain.CoverImage   `json:"cover_images"`
+	AttachedFiles []domain.File         `json:"attached_files"`
+	Status        domain.PostStatus     `json:"status"`
+	PublishAt     time.Time             `json:"publish_at"`
+	ExpiresAt     time.Time             `json:"expires_at"`
+	EventIds      []string              `json:"event_ids"`
+	Visibility    domain.PostVisibility `json:"visibility"`
+	Paths         map[string]bool       `json:"paths"`
+}
+
+type GetPost struct {
+	Post          domain.Post           `json:"post"`
+	UserReactions []domain.ReactionType `json:"user_reactions,omitempty"`
+	// UserVote contains the options chosen by the user, PollResults is nil when the results are hidden from the user
+	UserVote    []string            `json:"user_vote,omitempty"`
+	PollResults *domain.PollResults `json:"poll_results,omitempty"`
+	// Events contains the summaries of the referenced events, draft and deleted events are skipped
+	Events []domain.EventSummary `json:"events,omitempty"`
+}
+
+type PinPost struct {
+	PostId string `json:"post_id"`
+	UserId int64  `json:"user_id"`
+	// ExpiresAt is optional, zero value means the post stays pinned until it is unpinned
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+type Vote struct {
+	PostId    string   `json:"post_id"`
+	UserId    int64    `json:"user_id"`
+	OptionIds []string `json:"option_ids"`
 }
 
 type ActionRequest struct {
@@ -32,11 +67,42 @@ type ActionRequest struct {
 	UserId int64  `json:"user_id"`
 }
 
+type ListPostRevisions struct {
+	PostId string            `json:"post_id"`
+	UserId int64             `json:"user_id"`
+	Filter domain.BaseFilter `json:"filter"`
+}
+
+type RestorePostRevision struct {
+	PostId     string `json:"post_id"`
+	RevisionId string `json:"revision_id"`
+	UserId     int64  `json:"user_id"`
+}
+
+type GetPostStats struct {
+	PostId string `json:"post_id"`
+	UserId int64  `json:"user_id"`
+	// From and To limit the stats period by days, zero values mean the last 30 days
+	From time.Time `json:"from"`
+	To   time.Time `json:"to"`
+}
+
 type ListPostsRequest struct {
 	domain.BaseFilter
 	ClubId int64           `json:"club_id"`
+	UserId int64           `json:"user_id"`
 	Tags   []string        `json:"tags"`
 	Paths  map[string]bool `json:"paths"`
+	// FromDate and ToDate limit the posts by creation date, zero value means no limit
+	FromDate time.Time `json:"from_date"`
+	ToDate   time.Time `json:"to_date"`
+	// IncludeHidden and IncludeUnpublished are set by the service when the user is allowed to manage the club posts
+	IncludeHidden      bool `json:"-"`
+	IncludeUnpublished bool `json:"-"`
+	// MemberClubIds and ManagedClubIds are the clubs whose members only and managers only posts are listed to the user,
+	// they are set by the service
+	MemberClubIds  []int64 `json:"-"`
+	ManagedClubIds []int64 `json:"-"`
 }
 
 func ToCreatePostRequest(post *postv1.CreatePostRequest) *CreatePostRequest {
@@ -50,7 +116,7 @@ func ToCreatePostRequest(post *postv1.CreatePostRequest) *CreatePostRequest {
 		UserId:        post.GetUserId(),
 		Title:         post.GetTitle(),
 		Description:   post.GetDescription(),
-		Tags:          post.GetTags(),
+		Tags:          domain.NormalizeTags(post.GetTags()),
 		CoverImages:   domain.PbToCoverImages(post.GetCoverImages()),
 		AttachedFiles: domain.PbToFiles(post.GetAttachedFiles()),
 		Paths:         paths,
@@ -68,7 +134,7 @@ func ToUpdatePostRequest(post *postv1.UpdatePostRequest) *UpdatePostRequest {
 		UserId:        post.GetUserId(),
 		Title:         post.GetTitle(),
 		Description:   post.GetDescription(),
-		Tags:          post.GetTags(),
+		Tags:          domain.NormalizeTags(post.GetTags()),
 		CoverImages:   domain.PbToCoverImages(post.GetCoverImages()),
 		AttachedFiles: domain.PbToFiles(post.GetAttachedFiles()),
 		Paths:         paths,
@@ -91,7 +157,7 @@ func ToListPostsRequest(l *postv1.ListPostsRequest) *ListPostsRequest {
 			SortBy:   domain.SortBy(l.GetSortBy()),
 		},
 		ClubId: l.GetClubId(),
-		Tags:   l.GetTags(),
+		Tags:   domain.NormalizeTags(l.GetTags()),
 	}
 
 	paths := make(map[string]bool)
@@ -115,3 +181,12 @@ func ToListPostsRequest(l *postv1.ListPostsRequest) *ListPostsRequest {
 
 	return dto
 }
+
+// ListClubFeed lists the posts and the events of the club in one stream, the newest first
+type ListClubFeed struct {
+	domain.BaseFilter
+	ClubId int64 `json:"club_id"`
+	UserId int64 `json:"user_id"`
+	// Audience is set by the service, it decides which restricted posts and hidden events are listed
+	Audience domain.PostAudience `json:"-"`
+}
@@ -0,0 +1,10 @@
+package dtos
+
+import "github.com/arumandesu/uniclubs-posts-service/internal/domain"
+
+type Reaction struct {
+	TargetType domain.TargetType   `json:"target_type"`
+	TargetId   string              `json:"target_id"`
+	UserId     int64               `json:"user_id"`
+	Type       domain.ReactionType `json:"type"`
+}
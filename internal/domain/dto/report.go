@@ -0,0 +1,35 @@
+package dtos
+
+import "github.com/arumandesu/uniclubs-posts-service/internal/domain"
+
+type CreateReport struct {
+	TargetType domain.TargetType   `json:"target_type"`
+	TargetId   string              `json:"target_id"`
+	UserId     int64               `json:"user_id"`
+	Reason     domain.ReportReason `json:"reason"`
+	Text       string              `json:"text"`
+}
+
+// ListModerationQueue lists the targets with open reports, TargetType is optional
+type ListModerationQueue struct {
+	UserId     int64             `json:"user_id"`
+	IsAdmin    bool              `json:"is_admin"`
+	TargetType domain.TargetType `json:"target_type"`
+	Filter     domain.BaseFilter `json:"filter"`
+}
+
+type ListReports struct {
+	TargetType domain.TargetType `json:"target_type"`
+	TargetId   string            `json:"target_id"`
+	UserId     int64             `json:"user_id"`
+	IsAdmin    bool              `json:"is_admin"`
+	Filter     domain.BaseFilter `json:"filter"`
+}
+
+type ModerateReport struct {
+	ReportId string                  `json:"report_id"`
+	UserId   int64                   `json:"user_id"`
+	IsAdmin  bool                    `json:"is_admin"`
+	Action   domain.ModerationAction `json:"action"`
+	Note     string                  `json:"note"`
+}
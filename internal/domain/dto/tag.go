@@ -0,0 +1,9 @@
+package dtos
+
+// MergeTags merges the Source tag into the Target one, it is available to the admins only
+type MergeTags struct {
+	Source  string `json:"source"`
+	Target  string `json:"target"`
+	UserId  int64  `json:"user_id"`
+	IsAdmin bool   `json:"is_admin"`
+}
@@ -3,12 +3,29 @@ package domain
 import "errors"
 
 var (
-	ErrOrganizerNotFound    = errors.New("organizer not found")
-	ErrUserIsEventOwner     = errors.New("user is event owner")
-	ErrClubIsEventOwner     = errors.New("club is event owner")
-	ErrCollaboratorsEmpty   = errors.New("there is no collaborators")
-	ErrOrganizersEmpty      = errors.New("there is no organizers")
-	ErrCollaboratorNotFound = errors.New("collaborator not found")
-	ErrEventIsNotApproved   = errors.New("event is not approved")
-	ErrEventIsNotPublished  = errors.New("event is not published")
+	ErrOrganizerNotFound         = errors.New("organizer not found")
+	ErrUserIsEventOwner          = errors.New("user is event owner")
+	ErrUserIsFromAnotherClub     = errors.New("user is not member of the event club")
+	ErrClubIsEventOwner          = errors.New("club is event owner")
+	ErrCollaboratorsEmpty        = errors.New("there is no collaborators")
+	ErrOrganizersEmpty           = errors.New("there is no organizers")
+	ErrCollaboratorNotFound      = errors.New("collaborator not found")
+	ErrEventIsNotApproved        = errors.New("event is not approved")
+	ErrEventIsNotPublished       = errors.New("event is not published")
+	ErrInvalidOrganizerRole      = errors.New("invalid organizer role")
+	ErrOwnershipTransferNotFound = errors.New("ownership transfer not found")
+	ErrInvalidPostStatus         = errors.New("invalid post status")
+	ErrInvalidPostSchedule       = errors.New("invalid post schedule")
+	ErrCommentDeleted            = errors.New("comment is deleted")
+	ErrInvalidCommentBody        = errors.New("invalid comment body")
+	ErrInvalidPoll               = errors.New("invalid poll")
+	ErrInvalidPollChoice         = errors.New("invalid poll choice")
+	ErrPollClosed                = errors.New("poll is closed")
+	ErrInvalidPostEvents         = errors.New("invalid post events")
+	ErrInvalidPostVisibility     = errors.New("invalid post visibility")
+	ErrTooManyMentions           = errors.New("too many mentions")
+	ErrInvalidReport             = errors.New("invalid report")
+	ErrReportClosed              = errors.New("report is closed")
+	ErrInvalidModerationAction   = errors.New("invalid moderation action")
+	ErrInvalidTimeRange          = errors.New("invalid time range")
 )
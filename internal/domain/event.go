@@ -3,6 +3,8 @@ package domain
 import (
 	"fmt"
 	eventv1 "github.com/ARUMANDESU/uniclubs-protos/gen/go/posts/event"
+	"github.com/arumandesu/uniclubs-posts-service/pkg/markdown"
+	"slices"
 	"time"
 )
 
@@ -34,38 +36,115 @@ func (t EventType) String() string {
 }
 
 type Event struct {
-	ID                    string          `json:"id"`
-	ClubId                int64           `json:"club_id"`
-	OwnerId               int64           `json:"owner_id"`
-	CollaboratorClubs     []Club          `json:"collaborator_clubs"`
-	Organizers            []Organizer     `json:"organizers"`
-	Title                 string          `json:"title,omitempty"`
-	Description           string          `json:"description,omitempty"`
-	Type                  EventType       `json:"type,omitempty"`
-	Status                EventStatus     `json:"status,omitempty"`
-	Tags                  []string        `json:"tags,omitempty"`
-	MaxParticipants       uint32          `json:"max_participants,omitempty"`
-	ParticipantsCount     uint32          `json:"participants_count,omitempty"`
-	LocationLink          string          `json:"location_link,omitempty"`
-	LocationUniversity    string          `json:"location_university,omitempty"`
-	StartDate             time.Time       `json:"start_date"`
-	EndDate               time.Time       `json:"end_date"`
-	CoverImages           []CoverImage    `json:"cover_images,omitempty"`
-	AttachedImages        []File          `json:"attached_images,omitempty"`
-	AttachedFiles         []File          `json:"attached_files,omitempty"`
-	CreatedAt             time.Time       `json:"created_at"`
-	UpdatedAt             time.Time       `json:"updated_at"`
-	DeletedAt             time.Time       `json:"deleted_at"`
-	PublishedAt           time.Time       `json:"published_at"`
-	ApproveMetadata       ApproveMetadata `json:"approve_metadata"`
-	RejectMetadata        RejectMetadata  `json:"reject_metadata"`
-	IsHiddenForNonMembers bool            `json:"is_hidden_for_non_members"`
+	ID                       string             `json:"id"`
+	ClubId                   int64              `json:"club_id"`
+	OwnerId                  int64              `json:"owner_id"`
+	CollaboratorClubs        []Club             `json:"collaborator_clubs"`
+	Organizers               []Organizer        `json:"organizers"`
+	Title                    string             `json:"title,omitempty"`
+	Description              string             `json:"description,omitempty"`
+	DescriptionHTML          string             `json:"description_html,omitempty"`
+	Excerpt                  string             `json:"excerpt,omitempty"`
+	Mentions                 []Mention          `json:"mentions,omitempty"`
+	Type                     EventType          `json:"type,omitempty"`
+	Status                   EventStatus        `json:"status,omitempty"`
+	Tags                     []string           `json:"tags,omitempty"`
+	MaxParticipants          uint32             `json:"max_participants,omitempty"`
+	ParticipantsCount        uint32             `json:"participants_count,omitempty"`
+	LocationLink             string             `json:"location_link,omitempty"`
+	LocationUniversity       string             `json:"location_university,omitempty"`
+	StartDate                time.Time          `json:"start_date"`
+	EndDate                  time.Time          `json:"end_date"`
+	CoverImages              []CoverImage       `json:"cover_images,omitempty"`
+	AttachedImages           []File             `json:"attached_images,omitempty"`
+	AttachedFiles            []File             `json:"attached_files,omitempty"`
+	CreatedAt                time.Time          `json:"created_at"`
+	UpdatedAt                time.Time          `json:"updated_at"`
+	DeletedAt                time.Time          `json:"deleted_at"`
+	PublishedAt              time.Time          `json:"published_at"`
+	ApproveMetadata          ApproveMetadata    `json:"approve_metadata"`
+	RejectMetadata           RejectMetadata     `json:"reject_metadata"`
+	IsHiddenForNonMembers    bool               `json:"is_hidden_for_non_members"`
+	PendingOwnershipTransfer *OwnershipTransfer `json:"pending_ownership_transfer,omitempty"`
+	OwnershipHistory         []OwnershipRecord  `json:"ownership_history,omitempty"`
+	Reactions                Reactions          `json:"reactions"`
+	// AutoAnnounce makes the publishing create an announcement post in every collaborator club
+	AutoAnnounce bool `json:"auto_announce"`
+}
+
+// EventSummary is the short event info embedded into the posts which reference the event
+type EventSummary struct {
+	ID                 string       `json:"id"`
+	ClubId             int64        `json:"club_id"`
+	Title              string       `json:"title"`
+	Type               EventType    `json:"type"`
+	Status             EventStatus  `json:"status"`
+	LocationUniversity string       `json:"location_university,omitempty"`
+	StartDate          time.Time    `json:"start_date"`
+	EndDate            time.Time    `json:"end_date"`
+	CoverImages        []CoverImage `json:"cover_images,omitempty"`
+}
+
+func (e *Event) Summary() EventSummary {
+	return EventSummary{
+		ID:                 e.ID,
+		ClubId:             e.ClubId,
+		Title:              e.Title,
+		Type:               e.Type,
+		Status:             e.Status,
+		LocationUniversity: e.LocationUniversity,
+		StartDate:          e.StartDate,
+		EndDate:            e.EndDate,
+		CoverImages:        e.CoverImages,
+	}
+}
+
+// RenderDescription renders the markdown description into the sanitized HTML and its plain text excerpt
+func (e *Event) RenderDescription() error {
+	rendered, err := markdown.Render(e.Description)
+	if err != nil {
+		return err
+	}
+
+	e.DescriptionHTML = rendered
+	e.Excerpt = markdown.Excerpt(rendered, MaxExcerptLength)
+	return nil
 }
 
 func (e *Event) IsOwner(userId int64) bool {
 	return e.OwnerId == userId
 }
 
+// PublishedEventStatuses are the statuses of the published events, the events in the other statuses
+// are not listed publicly
+var PublishedEventStatuses = []EventStatus{EventStatusInProgress, EventStatusFinished}
+
+// IsPublished reports if the event has been published and is visible to everyone who can see the event list
+func (e *Event) IsPublished() bool {
+	return slices.Contains(PublishedEventStatuses, e.Status)
+}
+
+// IsVisibleTo reports if the user can open the event, draft events are visible only to the organizers
+func (e *Event) IsVisibleTo(userId int64) bool {
+	return e.Status != EventStatusDraft || e.IsOrganizer(userId)
+}
+
+// IsVisibleToMember reports if the event hidden for non-members is visible to the user,
+// memberClubIds are the clubs the user is a member of, the organizers always see the event
+func (e *Event) IsVisibleToMember(userId int64, memberClubIds []int64) bool {
+	if !e.IsHiddenForNonMembers || e.IsOrganizer(userId) {
+		return true
+	}
+
+	for _, club := range e.clubs() {
+		if slices.Contains(memberClubIds, club.ID) {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (e *Event) IsOrganizer(userId int64) bool {
 	for _, organizer := range e.Organizers {
 		if organizer.ID == userId {
@@ -86,6 +165,39 @@ func (e *Event) GetOrganizerById(userId int64) *Organizer {
 	return nil
 }
 
+// HasPermission checks if the user is allowed to perform the action on the event, the owner is allowed to do everything
+func (e *Event) HasPermission(userId int64, permission EventPermission) bool {
+	if e.IsOwner(userId) {
+		return true
+	}
+
+	organizer := e.GetOrganizerById(userId)
+	if organizer == nil {
+		return false
+	}
+
+	return organizer.HasPermission(permission)
+}
+
+func (e *Event) ChangeOrganizerRole(organizerId int64, role OrganizerRole) error {
+	if !role.IsValid() {
+		return ErrInvalidOrganizerRole
+	}
+
+	if organizerId == e.OwnerId {
+		return ErrUserIsEventOwner
+	}
+
+	for i, organizer := range e.Organizers {
+		if organizer.ID == organizerId {
+			e.Organizers[i].Role = role
+			return nil
+		}
+	}
+
+	return ErrOrganizerNotFound
+}
+
 func (e *Event) AddOrganizer(organizer Organizer) {
 	e.Organizers = append(e.Organizers, organizer)
 }
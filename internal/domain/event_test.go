@@ -29,6 +29,37 @@ func TestEventIsOrganizer(t *testing.T) {
 	assert.False(t, event.IsOrganizer(3))
 }
 
+func TestEventIsPublished(t *testing.T) {
+	for _, status := range []EventStatus{EventStatusDraft, EventStatusPending, EventStatusApproved, EventStatusCanceled} {
+		event := Event{Status: status}
+		assert.False(t, event.IsPublished(), status)
+	}
+	for _, status := range []EventStatus{EventStatusInProgress, EventStatusFinished} {
+		event := Event{Status: status}
+		assert.True(t, event.IsPublished(), status)
+	}
+}
+
+func TestEventIsVisibleTo(t *testing.T) {
+	event := Event{Status: EventStatusDraft, Organizers: []Organizer{{User: User{ID: 1}}}}
+	assert.True(t, event.IsVisibleTo(1))
+	assert.False(t, event.IsVisibleTo(2), "draft events are visible only to the organizers")
+
+	event.Status = EventStatusInProgress
+	assert.True(t, event.IsVisibleTo(2))
+}
+
+func TestEventIsVisibleToMember(t *testing.T) {
+	event := Event{Organizers: []Organizer{{User: User{ID: 1}}}, CollaboratorClubs: []Club{{ID: 10}, {ID: 20}}}
+	assert.True(t, event.IsVisibleToMember(2, nil))
+
+	event.IsHiddenForNonMembers = true
+	assert.True(t, event.IsVisibleToMember(1, nil), "the organizers always see the event")
+	assert.True(t, event.IsVisibleToMember(2, []int64{30, 20}))
+	assert.False(t, event.IsVisibleToMember(2, []int64{30}))
+	assert.False(t, event.IsVisibleToMember(0, nil))
+}
+
 func TestEventGetOrganizerById(t *testing.T) {
 	event := Event{
 		Organizers: []Organizer{
@@ -0,0 +1,38 @@
+package domain
+
+import "slices"
+
+// MaxFacetBuckets limits the tag and club buckets, the most frequent ones are returned
+const MaxFacetBuckets = 50
+
+// FacetBucket is the count of the events with the value, Label is the display name when the value is an id
+type FacetBucket struct {
+	Value string `json:"value"`
+	Label string `json:"label,omitempty"`
+	Count int64  `json:"count"`
+}
+
+// EventFacets holds the counts of the listed events by tag, status, type, club and start month
+type EventFacets struct {
+	Tags     []FacetBucket `json:"tags"`
+	Statuses []FacetBucket `json:"statuses"`
+	Types    []FacetBucket `json:"types"`
+	Clubs    []FacetBucket `json:"clubs"`
+	Months   []FacetBucket `json:"months"`
+}
+
+// FacetStatuses returns the statuses the facets are counted for, only the published events are listed publicly
+// so the requested statuses are narrowed to them, no status filter means every published status
+func (f EventsFilter) FacetStatuses() []EventStatus {
+	if len(f.Status) == 0 {
+		return PublishedEventStatuses
+	}
+
+	statuses := make([]EventStatus, 0, len(f.Status))
+	for _, status := range f.Status {
+		if slices.Contains(PublishedEventStatuses, status) {
+			statuses = append(statuses, status)
+		}
+	}
+	return statuses
+}
@@ -0,0 +1,19 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEventsFilter_FacetStatuses(t *testing.T) {
+	assert.Equal(t, PublishedEventStatuses, EventsFilter{}.FacetStatuses(), "no status filter counts every published status")
+	assert.Equal(t,
+		[]EventStatus{EventStatusInProgress, EventStatusFinished},
+		EventsFilter{Status: []EventStatus{EventStatusDraft, EventStatusPending, EventStatusInProgress, EventStatusFinished}}.FacetStatuses(),
+	)
+
+	statuses := EventsFilter{Status: []EventStatus{EventStatusDraft, EventStatusApproved}}.FacetStatuses()
+	assert.NotNil(t, statuses, "only unpublished statuses requested matches nothing")
+	assert.Empty(t, statuses)
+}
@@ -0,0 +1,28 @@
+package domain
+
+import "time"
+
+// MaxFeedPageSize limits the club feed page, it is also the default page size
+const MaxFeedPageSize = 50
+
+type FeedItemType string
+
+const (
+	FeedItemPost  FeedItemType = "POST"
+	FeedItemEvent FeedItemType = "EVENT"
+)
+
+func (t FeedItemType) String() string {
+	return string(t)
+}
+
+// FeedEventStatuses are the statuses of the published events shown in the club feed
+var FeedEventStatuses = PublishedEventStatuses
+
+// FeedItem is the post or the event of the club feed, Time is when it was published
+type FeedItem struct {
+	Type  FeedItemType `json:"type"`
+	Time  time.Time    `json:"time"`
+	Post  *Post        `json:"post,omitempty"`
+	Event *Event       `json:"event,omitempty"`
+}
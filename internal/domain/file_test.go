@@ -1,7 +1,7 @@
 package domain
 
 import (
-	eventv1 "github.com/ARUMANDESU/uniclubs-protos/gen/go/posts/event"
+	posts "github.com/ARUMANDESU/uniclubs-protos/gen/go/posts"
 	"github.com/stretchr/testify/assert"
 	"testing"
 )
@@ -39,7 +39,7 @@ func TestCoverImage_ToProto(t *testing.T) {
 }
 
 func TestProtoToFile(t *testing.T) {
-	protoFile := &eventv1.FileObject{
+	protoFile := &posts.FileObject{
 		Name: "testProtoFile",
 		Url:  "http://example.com/testProtoFile",
 		Type: "jpg",
@@ -53,7 +53,7 @@ func TestProtoToFile(t *testing.T) {
 }
 
 func TestProtoToCoverImage(t *testing.T) {
-	protoCoverImage := &eventv1.CoverImage{
+	protoCoverImage := &posts.CoverImage{
 		Name:     "testProtoCoverImage",
 		Url:      "http://example.com/testProtoCoverImage",
 		Type:     "jpg",
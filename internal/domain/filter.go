@@ -1,6 +1,7 @@
 package domain
 
 import (
+	"fmt"
 	eventv1 "github.com/ARUMANDESU/uniclubs-protos/gen/go/posts/event"
 	"time"
 )
@@ -15,6 +16,12 @@ const (
 	SortByDate         SortBy = "date"
 	SortByParticipants SortBy = "participants"
 	SortByType         SortBy = "type"
+
+	SortByCreatedAt SortBy = "created_at"
+	SortByUpdatedAt SortBy = "updated_at"
+	SortByTitle     SortBy = "title"
+	SortByRelevance SortBy = "relevance"
+	SortByReactions SortBy = "reactions"
 )
 
 func (s SortOrder) String() string {
@@ -31,6 +38,10 @@ type BaseFilter struct {
 	Query     string
 	SortBy    SortBy
 	SortOrder SortOrder
+	// Cursor is the NextCursor of the previous page, the page number is ignored when it is set
+	Cursor string
+	// SkipCount skips counting the total records, only the page size and the next cursor are returned in the metadata
+	SkipCount bool
 }
 
 type EventsFilter struct {
@@ -38,13 +49,97 @@ type EventsFilter struct {
 	ClubId                int64
 	UserId                int64
 	Tags                  []string
-	FromDate              time.Time
-	ToDate                time.Time
+	StartDate             TimeRange
+	EndDate               TimeRange
+	CreatedAt             TimeRange
+	ActiveDuring          TimeRange
+	Preset                EventTimePreset
 	Status                []EventStatus
 	IsHiddenForNonMembers bool
 	Paths                 []string
 }
 
+// TimeRange is the closed range of time, the zero bound is not limited
+type TimeRange struct {
+	From time.Time
+	To   time.Time
+}
+
+func (r TimeRange) IsZero() bool {
+	return r.From.IsZero() && r.To.IsZero()
+}
+
+func (r TimeRange) Validate() error {
+	if !r.From.IsZero() && !r.To.IsZero() && r.From.After(r.To) {
+		return fmt.Errorf("%w: from %s is after to %s", ErrInvalidTimeRange, r.From.Format(TimeLayout), r.To.Format(TimeLayout))
+	}
+	return nil
+}
+
+// Intersect returns the range which is inside both ranges
+func (r TimeRange) Intersect(other TimeRange) TimeRange {
+	result := r
+	if !other.From.IsZero() && (result.From.IsZero() || other.From.After(result.From)) {
+		result.From = other.From
+	}
+	if !other.To.IsZero() && (result.To.IsZero() || other.To.Before(result.To)) {
+		result.To = other.To
+	}
+	return result
+}
+
+// EventTimePreset selects the events by their dates relative to the current time
+type EventTimePreset string
+
+const (
+	EventsUpcoming EventTimePreset = "upcoming"
+	EventsOngoing  EventTimePreset = "ongoing"
+	EventsPast     EventTimePreset = "past"
+)
+
+func (p EventTimePreset) IsValid() bool {
+	switch p {
+	case "", EventsUpcoming, EventsOngoing, EventsPast:
+		return true
+	}
+	return false
+}
+
+func (f EventsFilter) ValidateDates() error {
+	if !f.Preset.IsValid() {
+		return fmt.Errorf("%w: unknown preset %q", ErrInvalidTimeRange, f.Preset)
+	}
+	for _, r := range []TimeRange{f.StartDate, f.EndDate, f.CreatedAt, f.ActiveDuring} {
+		if err := r.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+/*
+DateRanges returns the ranges of the event start and end dates which combine the filter ranges, the active window and the preset.
+
+	The event is active during the window when it starts before the window end and ends after the window start.
+	Upcoming events start after now, ongoing ones started and have not ended yet, past ones have ended.
+*/
+func (f EventsFilter) DateRanges(now time.Time) (start, end TimeRange) {
+	start = f.StartDate.Intersect(TimeRange{To: f.ActiveDuring.To})
+	end = f.EndDate.Intersect(TimeRange{From: f.ActiveDuring.From})
+
+	switch f.Preset {
+	case EventsUpcoming:
+		start = start.Intersect(TimeRange{From: now})
+	case EventsOngoing:
+		start = start.Intersect(TimeRange{To: now})
+		end = end.Intersect(TimeRange{From: now})
+	case EventsPast:
+		end = end.Intersect(TimeRange{To: now})
+	}
+
+	return start, end
+}
+
 func (f BaseFilter) Limit() int32 {
 	return f.PageSize
 }
@@ -56,9 +151,23 @@ func (f BaseFilter) Sort() string {
 	return f.SortBy.String()
 }
 
-func ProtoToFilers(req *eventv1.ListEventsRequest) EventsFilter {
-	fromDate, _ := time.Parse(TimeLayout, req.GetFilter().GetFromDate())
-	tillDate, _ := time.Parse(TimeLayout, req.GetFilter().GetTillDate())
+// ProtoToFilers converts the list request, from and till dates select the events active during the window
+func ProtoToFilers(req *eventv1.ListEventsRequest) (EventsFilter, error) {
+	filter := req.GetFilter()
+
+	fromDate, err := parseFilterDate(filter.GetFromDate())
+	if err != nil {
+		return EventsFilter{}, fmt.Errorf("%w: from_date: %w", ErrInvalidTimeRange, err)
+	}
+	tillDate, err := parseFilterDate(filter.GetTillDate())
+	if err != nil {
+		return EventsFilter{}, fmt.Errorf("%w: till_date: %w", ErrInvalidTimeRange, err)
+	}
+
+	activeDuring := TimeRange{From: fromDate, To: tillDate}
+	if err = activeDuring.Validate(); err != nil {
+		return EventsFilter{}, err
+	}
 
 	var sortOrder SortOrder
 	if req.GetSortOrder() == "" {
@@ -66,7 +175,6 @@ func ProtoToFilers(req *eventv1.ListEventsRequest) EventsFilter {
 	} else {
 		sortOrder = SortOrder(req.GetSortOrder())
 	}
-	filter := req.GetFilter()
 
 	return EventsFilter{
 		BaseFilter: BaseFilter{
@@ -78,13 +186,19 @@ func ProtoToFilers(req *eventv1.ListEventsRequest) EventsFilter {
 		},
 		ClubId:                filter.GetClubId(),
 		UserId:                filter.GetUserId(),
-		Tags:                  filter.GetTags(),
-		FromDate:              fromDate,
-		ToDate:                tillDate,
+		Tags:                  NormalizeTags(filter.GetTags()),
+		ActiveDuring:          activeDuring,
 		Status:                convertToEventStatusSlice(filter.GetStatus()),
 		IsHiddenForNonMembers: filter.GetIsHiddenForNonMembers(),
 		Paths:                 req.GetFilterMask().GetPaths(),
+	}, nil
+}
+
+func parseFilterDate(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
 	}
+	return time.Parse(TimeLayout, value)
 }
 
 func convertToEventStatusSlice(statuses []string) []EventStatus {
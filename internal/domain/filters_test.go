@@ -3,6 +3,7 @@ package domain
 import (
 	eventv1 "github.com/ARUMANDESU/uniclubs-protos/gen/go/posts/event"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"testing"
 	"time"
 )
@@ -64,22 +65,28 @@ func TestFilters_Limit(t *testing.T) {
 	}{
 		{
 			filters: EventsFilter{
-				Page:     1,
-				PageSize: 10,
+				BaseFilter: BaseFilter{
+					Page:     1,
+					PageSize: 10,
+				},
 			},
 			expectedLimit: 10,
 		},
 		{
 			filters: EventsFilter{
-				Page:     1,
-				PageSize: 1,
+				BaseFilter: BaseFilter{
+					Page:     1,
+					PageSize: 1,
+				},
 			},
 			expectedLimit: 1,
 		},
 		{
 			filters: EventsFilter{
-				Page:     1,
-				PageSize: -1,
+				BaseFilter: BaseFilter{
+					Page:     1,
+					PageSize: -1,
+				},
 			},
 			expectedLimit: -1,
 		},
@@ -102,22 +109,28 @@ func TestFilters_Offset(t *testing.T) {
 	}{
 		{
 			filters: EventsFilter{
-				Page:     1,
-				PageSize: 10,
+				BaseFilter: BaseFilter{
+					Page:     1,
+					PageSize: 10,
+				},
 			},
 			expectedOffset: 0,
 		},
 		{
 			filters: EventsFilter{
-				Page:     2,
-				PageSize: 20,
+				BaseFilter: BaseFilter{
+					Page:     2,
+					PageSize: 20,
+				},
 			},
 			expectedOffset: 20,
 		},
 		{
 			filters: EventsFilter{
-				Page:     5,
-				PageSize: 30,
+				BaseFilter: BaseFilter{
+					Page:     5,
+					PageSize: 30,
+				},
 			},
 			expectedOffset: 120,
 		},
@@ -154,20 +167,109 @@ func TestProtoToFilers_ValidRequest(t *testing.T) {
 	fromDate, _ := time.Parse(TimeLayout, req.GetFilter().GetFromDate())
 	tillDate, _ := time.Parse(TimeLayout, req.GetFilter().GetTillDate())
 	expectedFilters := EventsFilter{
-		Page:      req.GetPageNumber(),
-		PageSize:  req.GetPageSize(),
-		Query:     req.GetQuery(),
-		SortBy:    SortBy(req.GetSortBy()),
-		SortOrder: SortOrder(req.GetSortOrder()),
-		ClubId:    req.GetFilter().GetClubId(),
-		UserId:    req.GetFilter().GetUserId(),
-		Tags:      req.GetFilter().GetTags(),
-		FromDate:  fromDate,
-		ToDate:    tillDate,
-		Status:    []EventStatus{EventStatusInProgress, EventStatusFinished},
+		BaseFilter: BaseFilter{
+			Page:      req.GetPageNumber(),
+			PageSize:  req.GetPageSize(),
+			Query:     req.GetQuery(),
+			SortBy:    SortBy(req.GetSortBy()),
+			SortOrder: SortOrder(req.GetSortOrder()),
+		},
+		ClubId:       req.GetFilter().GetClubId(),
+		UserId:       req.GetFilter().GetUserId(),
+		Tags:         req.GetFilter().GetTags(),
+		ActiveDuring: TimeRange{From: fromDate, To: tillDate},
+		Status:       []EventStatus{EventStatusInProgress, EventStatusFinished},
 	}
 
-	filters := ProtoToFilers(req)
+	filters, err := ProtoToFilers(req)
+	require.NoError(t, err)
 
 	assert.Equal(t, expectedFilters, filters)
 }
+
+func TestProtoToFilers_InvalidDates(t *testing.T) {
+	now := time.Now()
+
+	_, err := ProtoToFilers(&eventv1.ListEventsRequest{Filter: &eventv1.EventFilter{FromDate: "yesterday"}})
+	assert.ErrorIs(t, err, ErrInvalidTimeRange)
+
+	_, err = ProtoToFilers(&eventv1.ListEventsRequest{Filter: &eventv1.EventFilter{
+		FromDate: now.Format(TimeLayout),
+		TillDate: now.Add(-24 * time.Hour).Format(TimeLayout),
+	}})
+	assert.ErrorIs(t, err, ErrInvalidTimeRange, "from date after till date")
+
+	filters, err := ProtoToFilers(&eventv1.ListEventsRequest{Filter: &eventv1.EventFilter{}})
+	require.NoError(t, err)
+	assert.True(t, filters.ActiveDuring.IsZero())
+}
+
+func TestTimeRange_Intersect(t *testing.T) {
+	day := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+
+	r := TimeRange{From: day, To: day.AddDate(0, 0, 10)}
+	assert.Equal(t, r, r.Intersect(TimeRange{}))
+	assert.Equal(t, r, TimeRange{}.Intersect(r))
+	assert.Equal(t, TimeRange{From: day.AddDate(0, 0, 2), To: day.AddDate(0, 0, 10)}, r.Intersect(TimeRange{From: day.AddDate(0, 0, 2)}))
+	assert.Equal(t, TimeRange{From: day, To: day.AddDate(0, 0, 5)}, r.Intersect(TimeRange{From: day.AddDate(0, 0, -5), To: day.AddDate(0, 0, 5)}))
+}
+
+func TestEventsFilter_DateRanges(t *testing.T) {
+	now := time.Date(2024, 5, 10, 12, 0, 0, 0, time.UTC)
+	from, to := now.AddDate(0, 0, -3), now.AddDate(0, 0, 3)
+
+	tests := []struct {
+		name          string
+		filter        EventsFilter
+		expectedStart TimeRange
+		expectedEnd   TimeRange
+	}{
+		{
+			name:   "no dates",
+			filter: EventsFilter{},
+		},
+		{
+			name:          "active during the window overlaps it",
+			filter:        EventsFilter{ActiveDuring: TimeRange{From: from, To: to}},
+			expectedStart: TimeRange{To: to},
+			expectedEnd:   TimeRange{From: from},
+		},
+		{
+			name:          "upcoming",
+			filter:        EventsFilter{Preset: EventsUpcoming},
+			expectedStart: TimeRange{From: now},
+		},
+		{
+			name:          "ongoing",
+			filter:        EventsFilter{Preset: EventsOngoing},
+			expectedStart: TimeRange{To: now},
+			expectedEnd:   TimeRange{From: now},
+		},
+		{
+			name:        "past",
+			filter:      EventsFilter{Preset: EventsPast},
+			expectedEnd: TimeRange{To: now},
+		},
+		{
+			name:          "start range with upcoming preset",
+			filter:        EventsFilter{StartDate: TimeRange{From: from, To: to}, Preset: EventsUpcoming},
+			expectedStart: TimeRange{From: now, To: to},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start, end := tt.filter.DateRanges(now)
+			assert.Equal(t, tt.expectedStart, start)
+			assert.Equal(t, tt.expectedEnd, end)
+		})
+	}
+}
+
+func TestEventsFilter_ValidateDates(t *testing.T) {
+	now := time.Now()
+
+	assert.NoError(t, EventsFilter{Preset: EventsPast, CreatedAt: TimeRange{From: now.Add(-time.Hour), To: now}}.ValidateDates())
+	assert.ErrorIs(t, EventsFilter{Preset: "tomorrow"}.ValidateDates(), ErrInvalidTimeRange)
+	assert.ErrorIs(t, EventsFilter{EndDate: TimeRange{From: now, To: now.Add(-time.Hour)}}.ValidateDates(), ErrInvalidTimeRange)
+}
@@ -3,17 +3,19 @@ package domain
 import eventv1 "github.com/ARUMANDESU/uniclubs-protos/gen/go/posts/event"
 
 type Invite struct {
-	ID    string `json:"id"`
-	Event Event  `json:"event"`
-	Club  Club   `json:"club"`
-}
-
-type UserInvite struct {
 	ID      string `json:"id"`
 	Event   Event  `json:"event"`
-	ClubId  int64  `json:"club_id"`
+	Club    Club   `json:"club"`
 	ByWhoId int64  `json:"by_who_id"`
-	User    User   `json:"user"`
+}
+
+type UserInvite struct {
+	ID      string        `json:"id"`
+	Event   Event         `json:"event"`
+	ClubId  int64         `json:"club_id"`
+	ByWhoId int64         `json:"by_who_id"`
+	User    User          `json:"user"`
+	Role    OrganizerRole `json:"role"`
 }
 
 func (u UserInvite) IsInvited(userId int64) bool {
@@ -0,0 +1,113 @@
+package domain
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"unicode"
+	"unicode/utf8"
+)
+
+type MentionType string
+
+const (
+	MentionUser MentionType = "USER"
+	MentionClub MentionType = "CLUB"
+)
+
+func (t MentionType) String() string {
+	return string(t)
+}
+
+// MaxMentionsCount limits the number of the distinct users and clubs mentioned in a single text
+const MaxMentionsCount = 20
+
+// mentionPattern matches the mentions written as @user:<id> and @club:<id>
+var mentionPattern = regexp.MustCompile(`@(user|club):(\d+)\b`)
+
+// Mention is the reference to the user or club in the text, Start and End are the character offsets of the mention in the text
+type Mention struct {
+	Type  MentionType `json:"type"`
+	ID    int64       `json:"id"`
+	Name  string      `json:"name"`
+	Start int         `json:"start"`
+	End   int         `json:"end"`
+}
+
+// MentionSource describes the post or event whose text contains the mentions
+type MentionSource struct {
+	TargetType TargetType
+	TargetId   string
+	Title      string
+	ClubId     int64
+	AuthorId   int64
+}
+
+/*
+ParseMentions returns the mentions found in the text in their order, their names are filled when they are resolved.
+
+	The mention must not be glued to the preceding word, so the e-mail like strings are not mentions.
+	The text can mention at most MaxMentionsCount distinct users and clubs.
+*/
+func ParseMentions(text string) ([]Mention, error) {
+	var mentions []Mention
+	distinct := make(map[Mention]bool)
+
+	for _, match := range mentionPattern.FindAllStringSubmatchIndex(text, -1) {
+		if match[0] > 0 {
+			prev, _ := utf8.DecodeLastRuneInString(text[:match[0]])
+			if prev == '_' || unicode.IsLetter(prev) || unicode.IsDigit(prev) {
+				continue
+			}
+		}
+
+		id, err := strconv.ParseInt(text[match[4]:match[5]], 10, 64)
+		if err != nil || id == 0 {
+			continue
+		}
+
+		mentionType := MentionUser
+		if text[match[2]:match[3]] == "club" {
+			mentionType = MentionClub
+		}
+
+		key := Mention{Type: mentionType, ID: id}
+		if !distinct[key] {
+			distinct[key] = true
+			if len(distinct) > MaxMentionsCount {
+				return nil, fmt.Errorf("%w: at most %d users and clubs can be mentioned", ErrTooManyMentions, MaxMentionsCount)
+			}
+		}
+
+		start := utf8.RuneCountInString(text[:match[0]])
+		mentions = append(mentions, Mention{
+			Type:  mentionType,
+			ID:    id,
+			Start: start,
+			End:   start + utf8.RuneCountInString(text[match[0]:match[1]]),
+		})
+	}
+
+	return mentions, nil
+}
+
+// NewlyMentionedUsers returns the distinct ids of the users mentioned in current but not in previous
+func NewlyMentionedUsers(previous, current []Mention) []int64 {
+	seen := make(map[int64]bool, len(previous))
+	for _, mention := range previous {
+		if mention.Type == MentionUser {
+			seen[mention.ID] = true
+		}
+	}
+
+	var userIds []int64
+	for _, mention := range current {
+		if mention.Type != MentionUser || seen[mention.ID] {
+			continue
+		}
+		seen[mention.ID] = true
+		userIds = append(userIds, mention.ID)
+	}
+
+	return userIds
+}
@@ -0,0 +1,61 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMentions(t *testing.T) {
+	mentions, err := ParseMentions("Talk by @user:12 with @club:3, hosted by @user:12")
+	require.NoError(t, err)
+	assert.Equal(t, []Mention{
+		{Type: MentionUser, ID: 12, Start: 8, End: 16},
+		{Type: MentionClub, ID: 3, Start: 22, End: 29},
+		{Type: MentionUser, ID: 12, Start: 41, End: 49},
+	}, mentions)
+
+	t.Run("offsets are counted in characters", func(t *testing.T) {
+		mentions, err := ParseMentions("Привет @user:7")
+		require.NoError(t, err)
+		require.Len(t, mentions, 1)
+		assert.Equal(t, 7, mentions[0].Start)
+		assert.Equal(t, 14, mentions[0].End)
+	})
+
+	t.Run("not mentions", func(t *testing.T) {
+		mentions, err := ParseMentions("mail me at john@user:5, @user:abc, @user:0, @user:12abc, @team:4")
+		require.NoError(t, err)
+		assert.Empty(t, mentions)
+	})
+
+	t.Run("too many mentions", func(t *testing.T) {
+		var text strings.Builder
+		for i := 1; i <= MaxMentionsCount+1; i++ {
+			fmt.Fprintf(&text, "@user:%d ", i)
+		}
+		_, err := ParseMentions(text.String())
+		assert.ErrorIs(t, err, ErrTooManyMentions)
+
+		// the same user mentioned many times counts once
+		_, err = ParseMentions(strings.Repeat("@user:1 ", MaxMentionsCount+1))
+		assert.NoError(t, err)
+	})
+}
+
+func TestNewlyMentionedUsers(t *testing.T) {
+	previous := []Mention{{Type: MentionUser, ID: 1}, {Type: MentionClub, ID: 2}}
+	current := []Mention{
+		{Type: MentionUser, ID: 1},
+		{Type: MentionUser, ID: 2},
+		{Type: MentionClub, ID: 3},
+		{Type: MentionUser, ID: 2},
+	}
+
+	assert.Equal(t, []int64{2}, NewlyMentionedUsers(previous, current))
+	assert.Equal(t, []int64{1, 2}, NewlyMentionedUsers(nil, current))
+	assert.Empty(t, NewlyMentionedUsers(current, previous))
+}
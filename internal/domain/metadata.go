@@ -13,6 +13,8 @@ type PaginationMetadata struct {
 	FirstPage    int32
 	LastPage     int32
 	TotalRecords int32
+	// NextCursor continues the listing after the page, it is empty on the last page
+	NextCursor string
 }
 
 type ApproveMetadata struct {
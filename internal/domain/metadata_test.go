@@ -1,7 +1,7 @@
 package domain
 
 import (
-	eventv1 "github.com/ARUMANDESU/uniclubs-protos/gen/go/posts/event"
+	posts "github.com/ARUMANDESU/uniclubs-protos/gen/go/posts"
 	"github.com/stretchr/testify/assert"
 	"testing"
 )
@@ -15,7 +15,7 @@ func TestPaginationMetadata_ToProto(t *testing.T) {
 		TotalRecords: 20,
 	}
 
-	expectedProto := &eventv1.PaginationMetadata{
+	expectedProto := &posts.PaginationMetadata{
 		CurrentPage:  metadata.CurrentPage,
 		PageSize:     metadata.PageSize,
 		FirstPage:    metadata.FirstPage,
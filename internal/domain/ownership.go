@@ -0,0 +1,85 @@
+package domain
+
+import "time"
+
+// OwnershipTransfer is a pending request to hand the event ownership to another organizer
+type OwnershipTransfer struct {
+	FromId      int64     `json:"from_id"`
+	ToId        int64     `json:"to_id"`
+	ByWhoId     int64     `json:"by_who_id"`
+	RequestedAt time.Time `json:"requested_at"`
+}
+
+// OwnershipRecord is a completed ownership transfer kept in the event history
+type OwnershipRecord struct {
+	FromId        int64     `json:"from_id"`
+	ToId          int64     `json:"to_id"`
+	ByWhoId       int64     `json:"by_who_id"`
+	TransferredAt time.Time `json:"transferred_at"`
+}
+
+// RequestOwnershipTransfer creates a pending ownership transfer to the organizer, replacing the previous pending one.
+// Only the organizers of the event club can own the event, the collaborator clubs organizers can't.
+func (e *Event) RequestOwnershipTransfer(toId, byWhoId int64) error {
+	if e.IsOwner(toId) {
+		return ErrUserIsEventOwner
+	}
+
+	organizer := e.GetOrganizerById(toId)
+	if organizer == nil {
+		return ErrOrganizerNotFound
+	}
+	if organizer.ClubId != e.ClubId {
+		return ErrUserIsFromAnotherClub
+	}
+
+	e.PendingOwnershipTransfer = &OwnershipTransfer{
+		FromId:      e.OwnerId,
+		ToId:        toId,
+		ByWhoId:     byWhoId,
+		RequestedAt: time.Now(),
+	}
+	return nil
+}
+
+// AcceptOwnershipTransfer makes the target of the pending transfer the event owner.
+// The previous owner stays in the organizers list with the default role.
+func (e *Event) AcceptOwnershipTransfer(userId int64) error {
+	transfer := e.PendingOwnershipTransfer
+	if transfer == nil || transfer.ToId != userId {
+		return ErrOwnershipTransferNotFound
+	}
+
+	if !e.IsOrganizer(userId) {
+		return ErrOrganizerNotFound
+	}
+
+	for i, organizer := range e.Organizers {
+		switch organizer.ID {
+		case e.OwnerId:
+			e.Organizers[i].Role = DefaultOrganizerRole
+		case userId:
+			e.Organizers[i].Role = OrganizerRoleCoOwner
+		}
+	}
+
+	e.OwnershipHistory = append(e.OwnershipHistory, OwnershipRecord{
+		FromId:        e.OwnerId,
+		ToId:          userId,
+		ByWhoId:       transfer.ByWhoId,
+		TransferredAt: time.Now(),
+	})
+	e.OwnerId = userId
+	e.PendingOwnershipTransfer = nil
+	return nil
+}
+
+// CancelOwnershipTransfer removes the pending ownership transfer
+func (e *Event) CancelOwnershipTransfer() error {
+	if e.PendingOwnershipTransfer == nil {
+		return ErrOwnershipTransferNotFound
+	}
+
+	e.PendingOwnershipTransfer = nil
+	return nil
+}
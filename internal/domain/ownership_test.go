@@ -0,0 +1,64 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEventRequestOwnershipTransfer(t *testing.T) {
+	event := Event{
+		OwnerId: 1,
+		ClubId:  10,
+		Organizers: []Organizer{
+			{User: User{ID: 1}, ClubId: 10, Role: OrganizerRoleCoOwner},
+			{User: User{ID: 2}, ClubId: 10},
+			{User: User{ID: 4}, ClubId: 20},
+		},
+	}
+
+	assert.ErrorIs(t, event.RequestOwnershipTransfer(1, 1), ErrUserIsEventOwner)
+	assert.ErrorIs(t, event.RequestOwnershipTransfer(3, 1), ErrOrganizerNotFound)
+	assert.ErrorIs(t, event.RequestOwnershipTransfer(4, 1), ErrUserIsFromAnotherClub)
+	assert.Nil(t, event.PendingOwnershipTransfer)
+
+	assert.NoError(t, event.RequestOwnershipTransfer(2, 1))
+	assert.NotNil(t, event.PendingOwnershipTransfer)
+	assert.Equal(t, int64(1), event.PendingOwnershipTransfer.FromId)
+	assert.Equal(t, int64(2), event.PendingOwnershipTransfer.ToId)
+}
+
+func TestEventAcceptOwnershipTransfer(t *testing.T) {
+	event := Event{
+		OwnerId: 1,
+		Organizers: []Organizer{
+			{User: User{ID: 1}, Role: OrganizerRoleCoOwner},
+			{User: User{ID: 2}, Role: OrganizerRoleViewer},
+		},
+	}
+
+	assert.ErrorIs(t, event.AcceptOwnershipTransfer(2), ErrOwnershipTransferNotFound)
+
+	assert.NoError(t, event.RequestOwnershipTransfer(2, 1))
+	assert.ErrorIs(t, event.AcceptOwnershipTransfer(3), ErrOwnershipTransferNotFound)
+
+	assert.NoError(t, event.AcceptOwnershipTransfer(2))
+	assert.Equal(t, int64(2), event.OwnerId)
+	assert.Nil(t, event.PendingOwnershipTransfer)
+	assert.Len(t, event.OwnershipHistory, 1)
+	assert.Equal(t, DefaultOrganizerRole, event.GetOrganizerById(1).Role)
+	assert.Equal(t, OrganizerRoleCoOwner, event.GetOrganizerById(2).Role)
+}
+
+func TestEventCancelOwnershipTransfer(t *testing.T) {
+	event := Event{
+		OwnerId:    1,
+		Organizers: []Organizer{{User: User{ID: 1}}, {User: User{ID: 2}}},
+	}
+
+	assert.ErrorIs(t, event.CancelOwnershipTransfer(), ErrOwnershipTransferNotFound)
+
+	assert.NoError(t, event.RequestOwnershipTransfer(2, 1))
+	assert.NoError(t, event.CancelOwnershipTransfer())
+	assert.Nil(t, event.PendingOwnershipTransfer)
+}
@@ -0,0 +1,177 @@
+package domain
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+type PostType string
+
+const (
+	PostTypeText PostType = "TEXT"
+	PostTypePoll PostType = "POLL"
+
+	MinPollOptions      = 2
+	MaxPollOptions      = 10
+	MaxPollOptionLength = 100
+)
+
+func (t PostType) String() string {
+	return string(t)
+}
+
+type Poll struct {
+	Options        []PollOption `json:"options"`
+	MultipleChoice bool         `json:"multiple_choice"`
+	// ClosesAt is optional, zero value means the poll is open until the post is deleted
+	ClosesAt  time.Time `json:"closes_at"`
+	Anonymous bool      `json:"anonymous"`
+	// HideResults hides the results from the user until the user votes or the poll is closed
+	HideResults bool `json:"hide_results"`
+	// MembersOnly allows only the club members to vote
+	MembersOnly bool `json:"members_only"`
+}
+
+type PollOption struct {
+	ID   string `json:"id"`
+	Text string `json:"text"`
+}
+
+type Vote struct {
+	PostId    string    `json:"post_id"`
+	UserId    int64     `json:"user_id"`
+	OptionIds []string  `json:"option_ids"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type PollResults struct {
+	Options     []PollOptionResult `json:"options"`
+	TotalVoters int64              `json:"total_voters"`
+}
+
+type PollOptionResult struct {
+	OptionId string `json:"option_id"`
+	Votes    int64  `json:"votes"`
+	// Voters is empty for anonymous polls
+	Voters []int64 `json:"voters,omitempty"`
+}
+
+// IsPoll reports if the post is a poll, posts created before post types were introduced have no type and are text posts
+func (p *Post) IsPoll() bool {
+	return p.Type == PostTypePoll && p.Poll != nil
+}
+
+// AttachPoll validates the poll, assigns ids to its options and makes the post a poll
+func (p *Post) AttachPoll(poll Poll, now time.Time) error {
+	options := make([]PollOption, len(poll.Options))
+	for i, option := range poll.Options {
+		options[i] = PollOption{
+			ID:   strconv.Itoa(i + 1),
+			Text: strings.TrimSpace(option.Text),
+		}
+	}
+	poll.Options = options
+
+	if err := poll.validate(now); err != nil {
+		return err
+	}
+
+	p.Type = PostTypePoll
+	p.Poll = &poll
+	return nil
+}
+
+func (p *Poll) validate(now time.Time) error {
+	if len(p.Options) < MinPollOptions || len(p.Options) > MaxPollOptions {
+		return fmt.Errorf("%w: poll must have from %d to %d options", ErrInvalidPoll, MinPollOptions, MaxPollOptions)
+	}
+
+	texts := make(map[string]bool, len(p.Options))
+	for _, option := range p.Options {
+		if option.Text == "" || utf8.RuneCountInString(option.Text) > MaxPollOptionLength {
+			return fmt.Errorf("%w: option text must have from 1 to %d characters", ErrInvalidPoll, MaxPollOptionLength)
+		}
+		if texts[option.Text] {
+			return fmt.Errorf("%w: duplicate option %q", ErrInvalidPoll, option.Text)
+		}
+		texts[option.Text] = true
+	}
+
+	if !p.ClosesAt.IsZero() && !p.ClosesAt.After(now) {
+		return fmt.Errorf("%w: closes_at must be in the future", ErrInvalidPoll)
+	}
+
+	return nil
+}
+
+func (p *Poll) IsClosed(now time.Time) bool {
+	return !p.ClosesAt.IsZero() && !now.Before(p.ClosesAt)
+}
+
+func (p *Poll) HasOption(optionId string) bool {
+	for _, option := range p.Options {
+		if option.ID == optionId {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateChoice checks that the poll is open and the chosen options are valid for the poll
+func (p *Poll) ValidateChoice(optionIds []string, now time.Time) error {
+	if p.IsClosed(now) {
+		return ErrPollClosed
+	}
+	if len(optionIds) == 0 {
+		return fmt.Errorf("%w: no options chosen", ErrInvalidPollChoice)
+	}
+	if !p.MultipleChoice && len(optionIds) > 1 {
+		return fmt.Errorf("%w: only one option can be chosen", ErrInvalidPollChoice)
+	}
+
+	chosen := make(map[string]bool, len(optionIds))
+	for _, optionId := range optionIds {
+		if !p.HasOption(optionId) {
+			return fmt.Errorf("%w: unknown option %q", ErrInvalidPollChoice, optionId)
+		}
+		if chosen[optionId] {
+			return fmt.Errorf("%w: option %q chosen twice", ErrInvalidPollChoice, optionId)
+		}
+		chosen[optionId] = true
+	}
+
+	return nil
+}
+
+// ResultsVisible reports if the results can be shown to the user
+func (p *Poll) ResultsVisible(hasVoted bool, now time.Time) bool {
+	return !p.HideResults || hasVoted || p.IsClosed(now)
+}
+
+// Results builds the results of every poll option in the poll order, options without votes are included with zero votes
+func (p *Poll) Results(tallies []PollOptionResult, totalVoters int64) PollResults {
+	byOption := make(map[string]PollOptionResult, len(tallies))
+	for _, tally := range tallies {
+		byOption[tally.OptionId] = tally
+	}
+
+	results := PollResults{
+		Options:     make([]PollOptionResult, 0, len(p.Options)),
+		TotalVoters: totalVoters,
+	}
+	for _, option := range p.Options {
+		result, ok := byOption[option.ID]
+		if !ok {
+			result = PollOptionResult{OptionId: option.ID}
+		}
+		if p.Anonymous {
+			result.Voters = nil
+		}
+		results.Options = append(results.Options, result)
+	}
+
+	return results
+}
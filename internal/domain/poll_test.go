@@ -0,0 +1,88 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestPoll(t *testing.T, poll Poll) *Post {
+	t.Helper()
+
+	post := &Post{}
+	require.NoError(t, post.AttachPoll(poll, time.Now()))
+	return post
+}
+
+func TestPost_AttachPoll(t *testing.T) {
+	now := time.Now()
+
+	post := &Post{}
+	err := post.AttachPoll(Poll{Options: []PollOption{{Text: " Yes "}, {Text: "No"}}}, now)
+	require.NoError(t, err)
+	assert.True(t, post.IsPoll())
+	assert.Equal(t, []PollOption{{ID: "1", Text: "Yes"}, {ID: "2", Text: "No"}}, post.Poll.Options)
+
+	tests := []struct {
+		name string
+		poll Poll
+	}{
+		{name: "too few options", poll: Poll{Options: []PollOption{{Text: "Yes"}}}},
+		{name: "empty option", poll: Poll{Options: []PollOption{{Text: "Yes"}, {Text: " "}}}},
+		{name: "duplicate options", poll: Poll{Options: []PollOption{{Text: "Yes"}, {Text: "Yes"}}}},
+		{name: "closes in the past", poll: Poll{Options: []PollOption{{Text: "Yes"}, {Text: "No"}}, ClosesAt: now.Add(-time.Hour)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			post := &Post{}
+			err := post.AttachPoll(tt.poll, now)
+			assert.ErrorIs(t, err, ErrInvalidPoll)
+			assert.False(t, post.IsPoll())
+		})
+	}
+}
+
+func TestPoll_ValidateChoice(t *testing.T) {
+	now := time.Now()
+	single := newTestPoll(t, Poll{Options: []PollOption{{Text: "A"}, {Text: "B"}, {Text: "C"}}}).Poll
+	multiple := newTestPoll(t, Poll{Options: []PollOption{{Text: "A"}, {Text: "B"}}, MultipleChoice: true}).Poll
+
+	assert.NoError(t, single.ValidateChoice([]string{"2"}, now))
+	assert.ErrorIs(t, single.ValidateChoice(nil, now), ErrInvalidPollChoice)
+	assert.ErrorIs(t, single.ValidateChoice([]string{"1", "2"}, now), ErrInvalidPollChoice)
+	assert.ErrorIs(t, single.ValidateChoice([]string{"4"}, now), ErrInvalidPollChoice)
+
+	assert.NoError(t, multiple.ValidateChoice([]string{"1", "2"}, now))
+	assert.ErrorIs(t, multiple.ValidateChoice([]string{"1", "1"}, now), ErrInvalidPollChoice)
+
+	single.ClosesAt = now.Add(-time.Minute)
+	assert.ErrorIs(t, single.ValidateChoice([]string{"2"}, now), ErrPollClosed)
+}
+
+func TestPoll_ResultsVisible(t *testing.T) {
+	now := time.Now()
+	poll := Poll{HideResults: true, ClosesAt: now.Add(time.Hour)}
+
+	assert.False(t, poll.ResultsVisible(false, now))
+	assert.True(t, poll.ResultsVisible(true, now))
+	assert.True(t, poll.ResultsVisible(false, now.Add(2*time.Hour)))
+
+	poll.HideResults = false
+	assert.True(t, poll.ResultsVisible(false, now))
+}
+
+func TestPoll_Results(t *testing.T) {
+	poll := newTestPoll(t, Poll{Options: []PollOption{{Text: "A"}, {Text: "B"}}, Anonymous: true}).Poll
+
+	results := poll.Results([]PollOptionResult{{OptionId: "2", Votes: 3, Voters: []int64{1, 2, 3}}}, 3)
+
+	assert.Equal(t, PollResults{
+		Options: []PollOptionResult{
+			{OptionId: "1"},
+			{OptionId: "2", Votes: 3},
+		},
+		TotalVoters: 3,
+	}, results)
+}
@@ -1,21 +1,256 @@
 package domain
 
 import (
+	"fmt"
 	postv1 "github.com/ARUMANDESU/uniclubs-protos/gen/go/posts/post"
+	"github.com/arumandesu/uniclubs-posts-service/pkg/markdown"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"time"
 )
 
+type PostStatus string
+
+const MaxPostEventsCount = 5
+
+// MaxExcerptLength is the length of the plain text excerpt of the post and event descriptions shown in the lists
+const MaxExcerptLength = 280
+
+const (
+	PostStatusDraft     PostStatus = "DRAFT"
+	PostStatusScheduled PostStatus = "SCHEDULED"
+	PostStatusPublished PostStatus = "PUBLISHED"
+	PostStatusExpired   PostStatus = "EXPIRED"
+)
+
+func (s PostStatus) String() string {
+	return string(s)
+}
+
+func (s PostStatus) IsValid() bool {
+	switch s {
+	case PostStatusDraft, PostStatusScheduled, PostStatusPublished, PostStatusExpired:
+		return true
+	}
+	return false
+}
+
+type PostVisibility string
+
+const (
+	PostVisibilityPublic   PostVisibility = "PUBLIC"
+	PostVisibilityMembers  PostVisibility = "MEMBERS"
+	PostVisibilityManagers PostVisibility = "MANAGERS"
+)
+
+func (v PostVisibility) String() string {
+	return string(v)
+}
+
+func (v PostVisibility) IsValid() bool {
+	switch v {
+	case PostVisibilityPublic, PostVisibilityMembers, PostVisibilityManagers:
+		return true
+	}
+	return false
+}
+
+// PostAudience describes the relation of the user to the club whose posts are read
+type PostAudience struct {
+	IsMember  bool
+	CanManage bool
+}
+
 type Post struct {
-	ID            string
-	Club          Club
-	Title         string
-	Description   string
-	Tags          []string
-	CoverImages   []CoverImage
-	AttachedFiles []File
-	CreatedAt     time.Time
-	UpdatedAt     time.Time
+	ID              string
+	Club            Club
+	Title           string
+	Description     string
+	DescriptionHTML string
+	Excerpt         string
+	Mentions        []Mention
+	Tags            []string
+	CoverImages     []CoverImage
+	AttachedFiles   []File
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+	Hidden          bool
+	HiddenBy        int64
+	HiddenAt        time.Time
+	Pinned          bool
+	PinnedBy        int64
+	PinnedAt        time.Time
+	PinExpiresAt    time.Time
+	Status          PostStatus
+	PublishAt       time.Time
+	ExpiresAt       time.Time
+	Reactions       Reactions
+	Type            PostType
+	Poll            *Poll
+	EventIds        []string
+	DeletedAt       time.Time
+	DeletedBy       int64
+	Visibility      PostVisibility
+}
+
+// RenderDescription renders the markdown description into the sanitized HTML and its plain text excerpt
+func (p *Post) RenderDescription() error {
+	rendered, err := markdown.Render(p.Description)
+	if err != nil {
+		return err
+	}
+
+	p.DescriptionHTML = rendered
+	p.Excerpt = markdown.Excerpt(rendered, MaxExcerptLength)
+	return nil
+}
+
+// IsRestricted reports if the post is not visible to everyone, posts without visibility were created before it was introduced and are public
+func (p *Post) IsRestricted() bool {
+	return p.Visibility == PostVisibilityMembers || p.Visibility == PostVisibilityManagers
+}
+
+// IsVisibleTo reports if the post visibility allows the audience to read it, the club post managers can read all posts
+func (p *Post) IsVisibleTo(audience PostAudience) bool {
+	switch p.Visibility {
+	case PostVisibilityMembers:
+		return audience.IsMember || audience.CanManage
+	case PostVisibilityManagers:
+		return audience.CanManage
+	default:
+		return true
+	}
+}
+
+// IsReadableBy reports if the audience can open the post: hidden, unpublished and deleted posts are visible only to the club
+// post managers, the published ones follow their visibility
+func (p *Post) IsReadableBy(audience PostAudience) bool {
+	if p.Hidden || !p.IsPublished() || p.IsDeleted() {
+		return audience.CanManage
+	}
+	return p.IsVisibleTo(audience)
+}
+
+// NeedsAudience reports if reading the post depends on the club audience, the published public posts are readable by everyone
+func (p *Post) NeedsAudience() bool {
+	return p.Hidden || !p.IsPublished() || p.IsDeleted() || p.IsRestricted()
+}
+
+func (p *Post) SetVisibility(visibility PostVisibility) error {
+	if !visibility.IsValid() {
+		return fmt.Errorf("%w: %s", ErrInvalidPostVisibility, visibility)
+	}
+	p.Visibility = visibility
+	return nil
+}
+
+// IsDeleted reports if the post is soft deleted, deleted posts can be restored until they are purged
+func (p *Post) IsDeleted() bool {
+	return !p.DeletedAt.IsZero()
+}
+
+// CanBeRestored reports if the deleted post is still within the restore window
+func (p *Post) CanBeRestored(now time.Time, window time.Duration) bool {
+	return p.IsDeleted() && now.Before(p.DeletedAt.Add(window))
+}
+
+// IsPinned reports if the post is pinned and the pin has not expired yet
+func (p *Post) IsPinned(now time.Time) bool {
+	return p.Pinned && (p.PinExpiresAt.IsZero() || p.PinExpiresAt.After(now))
+}
+
+// IsPublished reports if the post is visible to everyone, posts created before statuses were introduced have no status and are published
+func (p *Post) IsPublished() bool {
+	return p.Status == PostStatusPublished || p.Status == ""
+}
+
+/*
+SchedulePublication changes the post publication status.
+
+	Publishing with publishAt in the future makes the post scheduled, otherwise it is published right away.
+	expiresAt is optional, zero value means the post never expires.
+*/
+func (p *Post) SchedulePublication(status PostStatus, publishAt, expiresAt time.Time) error {
+	now := time.Now()
+
+	switch status {
+	case PostStatusDraft:
+	case PostStatusPublished, PostStatusScheduled:
+		if publishAt.IsZero() {
+			publishAt = now
+		}
+		if publishAt.After(now) {
+			status = PostStatusScheduled
+		} else {
+			status = PostStatusPublished
+		}
+	default:
+		return fmt.Errorf("%w: %s", ErrInvalidPostStatus, status)
+	}
+
+	if !expiresAt.IsZero() {
+		if !expiresAt.After(now) {
+			return fmt.Errorf("%w: expires_at must be in the future", ErrInvalidPostSchedule)
+		}
+		if !publishAt.IsZero() && !expiresAt.After(publishAt) {
+			return fmt.Errorf("%w: expires_at must be after publish_at", ErrInvalidPostSchedule)
+		}
+	}
+
+	p.Status = status
+	p.PublishAt = publishAt
+	p.ExpiresAt = expiresAt
+	return nil
+}
+
+// LinkEvents sets the events referenced by the post, duplicates are removed
+func (p *Post) LinkEvents(eventIds []string) error {
+	linked := make([]string, 0, len(eventIds))
+	seen := make(map[string]bool, len(eventIds))
+	for _, eventId := range eventIds {
+		if eventId == "" {
+			return fmt.Errorf("%w: empty event id", ErrInvalidPostEvents)
+		}
+		if seen[eventId] {
+			continue
+		}
+		seen[eventId] = true
+		linked = append(linked, eventId)
+	}
+
+	if len(linked) > MaxPostEventsCount {
+		return fmt.Errorf("%w: post can reference at most %d events", ErrInvalidPostEvents, MaxPostEventsCount)
+	}
+
+	p.EventIds = linked
+	return nil
+}
+
+// NewEventAnnouncement creates the published post of the club which announces the event and references it,
+// the announcement of the event hidden for non-members is visible to the club members only
+func NewEventAnnouncement(id string, event *Event, club Club, now time.Time) *Post {
+	visibility := PostVisibilityPublic
+	if event.IsHiddenForNonMembers {
+		visibility = PostVisibilityMembers
+	}
+
+	return &Post{
+		ID:              id,
+		Club:            club,
+		Title:           event.Title,
+		Description:     event.Description,
+		DescriptionHTML: event.DescriptionHTML,
+		Excerpt:         event.Excerpt,
+		Mentions:        event.Mentions,
+		Tags:            event.Tags,
+		CoverImages:     event.CoverImages,
+		CreatedAt:       now,
+		UpdatedAt:       now,
+		Status:          PostStatusPublished,
+		PublishAt:       now,
+		Type:            PostTypeText,
+		EventIds:        []string{event.ID},
+		Visibility:      visibility,
+	}
 }
 
 func PostToPb(post *Post) *postv1.PostObject {
@@ -0,0 +1,184 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPostSchedulePublication(t *testing.T) {
+	now := time.Now()
+
+	t.Run("publish now", func(t *testing.T) {
+		post := Post{}
+		assert.NoError(t, post.SchedulePublication(PostStatusPublished, time.Time{}, time.Time{}))
+		assert.Equal(t, PostStatusPublished, post.Status)
+		assert.False(t, post.PublishAt.IsZero())
+		assert.True(t, post.IsPublished())
+	})
+
+	t.Run("publish in the future becomes scheduled", func(t *testing.T) {
+		post := Post{}
+		assert.NoError(t, post.SchedulePublication(PostStatusPublished, now.Add(time.Hour), now.Add(2*time.Hour)))
+		assert.Equal(t, PostStatusScheduled, post.Status)
+		assert.False(t, post.IsPublished())
+	})
+
+	t.Run("draft", func(t *testing.T) {
+		post := Post{}
+		assert.NoError(t, post.SchedulePublication(PostStatusDraft, time.Time{}, time.Time{}))
+		assert.Equal(t, PostStatusDraft, post.Status)
+		assert.False(t, post.IsPublished())
+	})
+
+	t.Run("invalid status", func(t *testing.T) {
+		post := Post{}
+		assert.ErrorIs(t, post.SchedulePublication(PostStatusExpired, time.Time{}, time.Time{}), ErrInvalidPostStatus)
+		assert.ErrorIs(t, post.SchedulePublication("UNKNOWN", time.Time{}, time.Time{}), ErrInvalidPostStatus)
+	})
+
+	t.Run("expires before publish", func(t *testing.T) {
+		post := Post{}
+		err := post.SchedulePublication(PostStatusScheduled, now.Add(2*time.Hour), now.Add(time.Hour))
+		assert.ErrorIs(t, err, ErrInvalidPostSchedule)
+	})
+
+	t.Run("expires in the past", func(t *testing.T) {
+		post := Post{}
+		err := post.SchedulePublication(PostStatusPublished, time.Time{}, now.Add(-time.Hour))
+		assert.ErrorIs(t, err, ErrInvalidPostSchedule)
+	})
+}
+
+func TestPostIsPublished(t *testing.T) {
+	assert.True(t, (&Post{}).IsPublished())
+	assert.True(t, (&Post{Status: PostStatusPublished}).IsPublished())
+	assert.False(t, (&Post{Status: PostStatusExpired}).IsPublished())
+}
+
+func TestPostIsPinned(t *testing.T) {
+	now := time.Now()
+
+	assert.False(t, (&Post{}).IsPinned(now))
+	assert.True(t, (&Post{Pinned: true}).IsPinned(now))
+	assert.True(t, (&Post{Pinned: true, PinExpiresAt: now.Add(time.Hour)}).IsPinned(now))
+	assert.False(t, (&Post{Pinned: true, PinExpiresAt: now.Add(-time.Hour)}).IsPinned(now))
+}
+
+func TestPostLinkEvents(t *testing.T) {
+	post := Post{}
+
+	err := post.LinkEvents([]string{"a", "b", "a"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, post.EventIds)
+
+	err = post.LinkEvents(nil)
+	assert.NoError(t, err)
+	assert.Empty(t, post.EventIds)
+
+	err = post.LinkEvents([]string{""})
+	assert.ErrorIs(t, err, ErrInvalidPostEvents)
+
+	err = post.LinkEvents([]string{"1", "2", "3", "4", "5", "6"})
+	assert.ErrorIs(t, err, ErrInvalidPostEvents)
+}
+
+func TestNewEventAnnouncement(t *testing.T) {
+	now := time.Now()
+	event := &Event{ID: "event-id", Title: "Hackathon", Description: "Join us", Tags: []string{"it"}}
+	club := Club{ID: 2, Name: "Chess club"}
+
+	post := NewEventAnnouncement("post-id", event, club, now)
+
+	assert.Equal(t, "post-id", post.ID)
+	assert.Equal(t, club, post.Club)
+	assert.Equal(t, "Hackathon", post.Title)
+	assert.Equal(t, []string{"event-id"}, post.EventIds)
+	assert.True(t, post.IsPublished())
+	assert.Equal(t, PostVisibilityPublic, post.Visibility)
+
+	event.IsHiddenForNonMembers = true
+	post = NewEventAnnouncement("post-id", event, club, now)
+	assert.Equal(t, PostVisibilityMembers, post.Visibility, "the hidden event is announced to the members only")
+}
+
+func TestPostIsVisibleTo(t *testing.T) {
+	anonymous := PostAudience{}
+	member := PostAudience{IsMember: true}
+	manager := PostAudience{CanManage: true}
+
+	tests := []struct {
+		visibility PostVisibility
+		restricted bool
+		visible    []bool
+	}{
+		{visibility: "", restricted: false, visible: []bool{true, true, true}},
+		{visibility: PostVisibilityPublic, restricted: false, visible: []bool{true, true, true}},
+		{visibility: PostVisibilityMembers, restricted: true, visible: []bool{false, true, true}},
+		{visibility: PostVisibilityManagers, restricted: true, visible: []bool{false, false, true}},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.visibility), func(t *testing.T) {
+			post := Post{Visibility: tt.visibility}
+			assert.Equal(t, tt.restricted, post.IsRestricted())
+			assert.Equal(t, tt.visible[0], post.IsVisibleTo(anonymous))
+			assert.Equal(t, tt.visible[1], post.IsVisibleTo(member))
+			assert.Equal(t, tt.visible[2], post.IsVisibleTo(manager))
+		})
+	}
+}
+
+func TestPostIsReadableBy(t *testing.T) {
+	member := PostAudience{IsMember: true}
+	manager := PostAudience{IsMember: true, CanManage: true}
+
+	tests := []struct {
+		name          string
+		post          Post
+		member        bool
+		needsAudience bool
+	}{
+		{name: "public", post: Post{Status: PostStatusPublished}, member: true},
+		{name: "members only", post: Post{Status: PostStatusPublished, Visibility: PostVisibilityMembers}, member: true, needsAudience: true},
+		{name: "hidden", post: Post{Status: PostStatusPublished, Hidden: true}, needsAudience: true},
+		{name: "draft", post: Post{Status: PostStatusDraft}, needsAudience: true},
+		{name: "scheduled", post: Post{Status: PostStatusScheduled}, needsAudience: true},
+		{name: "deleted", post: Post{Status: PostStatusPublished, DeletedAt: time.Now()}, needsAudience: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.needsAudience, tt.post.NeedsAudience())
+			assert.Equal(t, tt.member, tt.post.IsReadableBy(member))
+			assert.True(t, tt.post.IsReadableBy(manager), "the club post managers read every post")
+		})
+	}
+}
+
+func TestPostSetVisibility(t *testing.T) {
+	post := Post{}
+	assert.NoError(t, post.SetVisibility(PostVisibilityMembers))
+	assert.Equal(t, PostVisibilityMembers, post.Visibility)
+
+	assert.ErrorIs(t, post.SetVisibility("FRIENDS"), ErrInvalidPostVisibility)
+	assert.ErrorIs(t, post.SetVisibility(""), ErrInvalidPostVisibility)
+	assert.Equal(t, PostVisibilityMembers, post.Visibility)
+}
+
+func TestPostRenderDescription(t *testing.T) {
+	post := Post{Description: "Join the **chess** night <script>alert(1)</script>"}
+
+	assert.NoError(t, post.RenderDescription())
+	assert.Contains(t, post.DescriptionHTML, "<strong>chess</strong>")
+	assert.NotContains(t, post.DescriptionHTML, "<script>")
+	assert.NotContains(t, post.Excerpt, "<")
+	assert.True(t, strings.HasPrefix(post.Excerpt, "Join the chess night"))
+
+	post.Description = ""
+	assert.NoError(t, post.RenderDescription())
+	assert.Empty(t, post.DescriptionHTML)
+	assert.Empty(t, post.Excerpt)
+}
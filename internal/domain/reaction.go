@@ -0,0 +1,46 @@
+package domain
+
+import "time"
+
+type ReactionType string
+
+const (
+	ReactionLike  ReactionType = "LIKE"
+	ReactionLove  ReactionType = "LOVE"
+	ReactionLaugh ReactionType = "LAUGH"
+	ReactionWow   ReactionType = "WOW"
+	ReactionSad   ReactionType = "SAD"
+	ReactionFire  ReactionType = "FIRE"
+)
+
+var reactionTypes = map[ReactionType]bool{
+	ReactionLike:  true,
+	ReactionLove:  true,
+	ReactionLaugh: true,
+	ReactionWow:   true,
+	ReactionSad:   true,
+	ReactionFire:  true,
+}
+
+func (r ReactionType) String() string {
+	return string(r)
+}
+
+func (r ReactionType) IsValid() bool {
+	return reactionTypes[r]
+}
+
+// Reaction is the reaction of the user, a user can have at most one reaction of each type on the target
+type Reaction struct {
+	TargetType TargetType   `json:"target_type"`
+	TargetId   string       `json:"target_id"`
+	UserId     int64        `json:"user_id"`
+	Type       ReactionType `json:"type"`
+	CreatedAt  time.Time    `json:"created_at"`
+}
+
+// Reactions holds the reaction counters of the post or the event
+type Reactions struct {
+	Counts map[ReactionType]int64 `json:"counts,omitempty"`
+	Total  int64                  `json:"total"`
+}
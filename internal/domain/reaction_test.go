@@ -0,0 +1,14 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReactionType_IsValid(t *testing.T) {
+	assert.True(t, ReactionLike.IsValid())
+	assert.True(t, ReactionFire.IsValid())
+	assert.False(t, ReactionType("DISLIKE").IsValid())
+	assert.False(t, ReactionType("").IsValid())
+}
@@ -0,0 +1,229 @@
+package domain
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"time"
+)
+
+const (
+	// MaxRecommendedEvents limits the recommendation feed
+	MaxRecommendedEvents = 20
+	// RecommendationHistorySize is the number of the last attended events the user interests are learned from
+	RecommendationHistorySize = 50
+	// RecommendationCandidatesSize is the number of the soonest upcoming events which are ranked
+	RecommendationCandidatesSize = 200
+)
+
+// the weights of the ranking signals, every signal is scaled to [0, 1] before it is weighted
+const (
+	tagAffinityWeight  = 3.0
+	clubAffinityWeight = 2.0
+	membershipWeight   = 2.0
+	popularityWeight   = 1.0
+	recencyWeight      = 1.5
+
+	// popularHalfCount is the participants count which makes an event without the limit half popular
+	popularHalfCount = 20
+	// popularRatio is the share of the taken places from which the event is explained as popular
+	popularRatio = 0.5
+	// recencyHalfLife is the time until the start at which the recency signal is halved
+	recencyHalfLife  = 7 * 24 * time.Hour
+	startsSoonWithin = 3 * 24 * time.Hour
+)
+
+type RecommendationReason string
+
+const (
+	RecommendationAttendedTag  RecommendationReason = "ATTENDED_TAG"
+	RecommendationAttendedClub RecommendationReason = "ATTENDED_CLUB"
+	RecommendationClubMember   RecommendationReason = "CLUB_MEMBER"
+	RecommendationPopular      RecommendationReason = "POPULAR"
+	RecommendationStartsSoon   RecommendationReason = "STARTS_SOON"
+)
+
+func (r RecommendationReason) String() string {
+	return string(r)
+}
+
+// RecommendationExplanation tells the user why the event is recommended, e.g. "because you attended "AI Meetup""
+type RecommendationExplanation struct {
+	Reason RecommendationReason `json:"reason"`
+	Text   string               `json:"text"`
+}
+
+type RecommendedEvent struct {
+	Event        Event                       `json:"event"`
+	Score        float64                     `json:"score"`
+	Explanations []RecommendationExplanation `json:"explanations"`
+}
+
+// Interest is how many attended events share the tag or the club, LastEvent is the title of the latest of them
+type Interest struct {
+	Count     int    `json:"count"`
+	LastEvent string `json:"last_event"`
+}
+
+// UserInterests is the user profile the events are ranked for
+type UserInterests struct {
+	Tags        map[string]Interest `json:"tags"`
+	Clubs       map[int64]Interest  `json:"clubs"`
+	MemberClubs map[int64]Club      `json:"member_clubs"`
+}
+
+// NewUserInterests builds the profile from the attended events, the latest first, and the clubs the user is a member of
+func NewUserInterests(attended []Event, memberClubs []Club) UserInterests {
+	interests := UserInterests{
+		Tags:        make(map[string]Interest),
+		Clubs:       make(map[int64]Interest),
+		MemberClubs: make(map[int64]Club, len(memberClubs)),
+	}
+
+	for _, event := range attended {
+		for _, tag := range event.Tags {
+			interests.Tags[tag] = interests.Tags[tag].add(event.Title)
+		}
+		for _, club := range event.clubs() {
+			interests.Clubs[club.ID] = interests.Clubs[club.ID].add(event.Title)
+		}
+	}
+	for _, club := range memberClubs {
+		interests.MemberClubs[club.ID] = club
+	}
+
+	return interests
+}
+
+func (i Interest) add(title string) Interest {
+	if i.Count == 0 {
+		i.LastEvent = title
+	}
+	i.Count++
+	return i
+}
+
+// saturation scales the interest to [0, 1), the first attended events matter the most
+func (i Interest) saturation() float64 {
+	return float64(i.Count) / float64(i.Count+2)
+}
+
+/*
+Recommend scores the event for the user, false is returned when the event can't be recommended:
+it is not published, has already started, is full or is hidden from the user who is not a member of its clubs.
+
+	The score is the weighted sum of the tag and club affinity learned from the attended events,
+	the membership in the event clubs, the popularity and how soon the event starts.
+*/
+func (i UserInterests) Recommend(event Event, now time.Time) (RecommendedEvent, bool) {
+	if event.Status != EventStatusInProgress || !event.StartDate.After(now) {
+		return RecommendedEvent{}, false
+	}
+	if event.MaxParticipants > 0 && event.ParticipantsCount >= event.MaxParticipants {
+		return RecommendedEvent{}, false
+	}
+
+	result := RecommendedEvent{Event: event}
+	explain := func(reason RecommendationReason, format string, args ...any) {
+		result.Explanations = append(result.Explanations, RecommendationExplanation{Reason: reason, Text: fmt.Sprintf(format, args...)})
+	}
+
+	var tagAffinity float64
+	var bestTag string
+	for _, tag := range event.Tags {
+		interest, ok := i.Tags[tag]
+		if !ok {
+			continue
+		}
+		tagAffinity += interest.saturation()
+		if bestTag == "" || interest.Count > i.Tags[bestTag].Count {
+			bestTag = tag
+		}
+	}
+	if bestTag != "" {
+		result.Score += tagAffinityWeight * min(tagAffinity, 1)
+		explain(RecommendationAttendedTag, "because you attended %q tagged %s", i.Tags[bestTag].LastEvent, bestTag)
+	}
+
+	var clubAffinity float64
+	var bestClub, memberClub Club
+	for _, club := range event.clubs() {
+		if interest, ok := i.Clubs[club.ID]; ok && interest.saturation() > clubAffinity {
+			clubAffinity = interest.saturation()
+			bestClub = club
+		}
+		if member, ok := i.MemberClubs[club.ID]; ok && memberClub.ID == 0 {
+			memberClub = member
+		}
+	}
+	if event.IsHiddenForNonMembers && memberClub.ID == 0 {
+		return RecommendedEvent{}, false
+	}
+	if bestClub.ID != 0 {
+		result.Score += clubAffinityWeight * clubAffinity
+		explain(RecommendationAttendedClub, "because you attended %q by %s", i.Clubs[bestClub.ID].LastEvent, bestClub.Name)
+	}
+	if memberClub.ID != 0 {
+		result.Score += membershipWeight
+		explain(RecommendationClubMember, "because you are a member of %s", memberClub.Name)
+	}
+
+	popularity := event.popularity()
+	result.Score += popularityWeight * popularity
+	if popularity >= popularRatio {
+		if event.MaxParticipants > 0 {
+			explain(RecommendationPopular, "popular: %d of %d places taken", event.ParticipantsCount, event.MaxParticipants)
+		} else {
+			explain(RecommendationPopular, "popular: %d participants", event.ParticipantsCount)
+		}
+	}
+
+	untilStart := event.StartDate.Sub(now)
+	result.Score += recencyWeight / (1 + float64(untilStart)/float64(recencyHalfLife))
+	if untilStart <= startsSoonWithin {
+		explain(RecommendationStartsSoon, "starts soon")
+	}
+
+	return result, true
+}
+
+// RankRecommendations scores the events for the user and returns at most limit of them, the best first
+func RankRecommendations(events []Event, interests UserInterests, now time.Time, limit int) []RecommendedEvent {
+	result := make([]RecommendedEvent, 0, len(events))
+	for _, event := range events {
+		if recommended, ok := interests.Recommend(event, now); ok {
+			result = append(result, recommended)
+		}
+	}
+
+	slices.SortFunc(result, func(a, b RecommendedEvent) int {
+		if c := cmp.Compare(b.Score, a.Score); c != 0 {
+			return c
+		}
+		if c := a.Event.StartDate.Compare(b.Event.StartDate); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Event.ID, b.Event.ID)
+	})
+
+	if limit > 0 && len(result) > limit {
+		result = result[:limit]
+	}
+	return result
+}
+
+// popularity is the share of the taken places, the events without the limit are compared by the participants count
+func (e *Event) popularity() float64 {
+	if e.MaxParticipants > 0 {
+		return min(float64(e.ParticipantsCount)/float64(e.MaxParticipants), 1)
+	}
+	return float64(e.ParticipantsCount) / float64(e.ParticipantsCount+popularHalfCount)
+}
+
+// clubs returns the clubs of the event, the host club is always one of the collaborator clubs
+func (e *Event) clubs() []Club {
+	if len(e.CollaboratorClubs) == 0 && e.ClubId != 0 {
+		return []Club{{ID: e.ClubId}}
+	}
+	return e.CollaboratorClubs
+}
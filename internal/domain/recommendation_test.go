@@ -0,0 +1,109 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserInterests_Recommend(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	chess := Club{ID: 1, Name: "Chess Club"}
+	robotics := Club{ID: 2, Name: "Robotics Club"}
+
+	interests := NewUserInterests([]Event{
+		{Title: "AI Meetup", Tags: []string{"ai"}, CollaboratorClubs: []Club{robotics}},
+		{Title: "Chess Night", Tags: []string{"chess"}, CollaboratorClubs: []Club{chess}},
+	}, []Club{chess})
+
+	upcoming := func(event Event) Event {
+		event.Status = EventStatusInProgress
+		event.StartDate = now.Add(10 * 24 * time.Hour)
+		return event
+	}
+
+	tests := []struct {
+		name    string
+		event   Event
+		ok      bool
+		reasons []RecommendationReason
+	}{
+		{
+			name:    "attended tag and club",
+			event:   upcoming(Event{Tags: []string{"ai"}, CollaboratorClubs: []Club{robotics}}),
+			ok:      true,
+			reasons: []RecommendationReason{RecommendationAttendedTag, RecommendationAttendedClub},
+		},
+		{
+			name:    "member club",
+			event:   upcoming(Event{CollaboratorClubs: []Club{chess}, IsHiddenForNonMembers: true}),
+			ok:      true,
+			reasons: []RecommendationReason{RecommendationAttendedClub, RecommendationClubMember},
+		},
+		{
+			name:    "popular",
+			event:   upcoming(Event{MaxParticipants: 10, ParticipantsCount: 8}),
+			ok:      true,
+			reasons: []RecommendationReason{RecommendationPopular},
+		},
+		{
+			name:  "hidden for non members",
+			event: upcoming(Event{CollaboratorClubs: []Club{robotics}, IsHiddenForNonMembers: true}),
+		},
+		{
+			name:  "full",
+			event: upcoming(Event{MaxParticipants: 10, ParticipantsCount: 10}),
+		},
+		{
+			name:  "already started",
+			event: Event{Status: EventStatusInProgress, StartDate: now.Add(-time.Hour)},
+		},
+		{
+			name:  "not published",
+			event: Event{Status: EventStatusApproved, StartDate: now.Add(time.Hour)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recommended, ok := interests.Recommend(tt.event, now)
+			require.Equal(t, tt.ok, ok)
+			if !ok {
+				return
+			}
+
+			reasons := make([]RecommendationReason, 0, len(recommended.Explanations))
+			for _, explanation := range recommended.Explanations {
+				reasons = append(reasons, explanation.Reason)
+			}
+			assert.Equal(t, tt.reasons, reasons)
+			assert.Positive(t, recommended.Score)
+		})
+	}
+
+	recommended, ok := interests.Recommend(upcoming(Event{Tags: []string{"ai"}}), now)
+	require.True(t, ok)
+	assert.Equal(t, `because you attended "AI Meetup" tagged ai`, recommended.Explanations[0].Text)
+}
+
+func TestRankRecommendations(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	interests := NewUserInterests([]Event{{Title: "AI Meetup", Tags: []string{"ai"}}}, nil)
+
+	event := func(id string, startsIn time.Duration, tags ...string) Event {
+		return Event{ID: id, Status: EventStatusInProgress, StartDate: now.Add(startsIn), Tags: tags}
+	}
+
+	ranked := RankRecommendations([]Event{
+		event("soon", 24*time.Hour),
+		event("later", 30*24*time.Hour),
+		event("ai", 14*24*time.Hour, "ai"),
+		event("started", -time.Hour),
+	}, interests, now, 2)
+
+	require.Len(t, ranked, 2)
+	assert.Equal(t, "ai", ranked[0].Event.ID, "the attended tag outweighs the recency")
+	assert.Equal(t, "soon", ranked[1].Event.ID)
+}
@@ -0,0 +1,148 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const MaxReportTextLength = 1000
+
+type ReportReason string
+
+const (
+	ReportReasonSpam           ReportReason = "SPAM"
+	ReportReasonHarassment     ReportReason = "HARASSMENT"
+	ReportReasonInappropriate  ReportReason = "INAPPROPRIATE"
+	ReportReasonMisinformation ReportReason = "MISINFORMATION"
+	ReportReasonOther          ReportReason = "OTHER"
+)
+
+var reportReasons = map[ReportReason]bool{
+	ReportReasonSpam:           true,
+	ReportReasonHarassment:     true,
+	ReportReasonInappropriate:  true,
+	ReportReasonMisinformation: true,
+	ReportReasonOther:          true,
+}
+
+func (r ReportReason) String() string {
+	return string(r)
+}
+
+func (r ReportReason) IsValid() bool {
+	return reportReasons[r]
+}
+
+type ReportStatus string
+
+const (
+	ReportStatusOpen      ReportStatus = "OPEN"
+	ReportStatusDismissed ReportStatus = "DISMISSED"
+	ReportStatusResolved  ReportStatus = "RESOLVED"
+)
+
+func (s ReportStatus) String() string {
+	return string(s)
+}
+
+// ModerationAction is the action taken by the admin on the reported target
+type ModerationAction string
+
+const (
+	ModerationDismiss     ModerationAction = "DISMISS"
+	ModerationHidePost    ModerationAction = "HIDE_POST"
+	ModerationDeleteEvent ModerationAction = "DELETE_EVENT"
+)
+
+func (a ModerationAction) String() string {
+	return string(a)
+}
+
+// Validate checks that the action can be applied to the target type
+func (a ModerationAction) Validate(targetType TargetType) error {
+	switch a {
+	case ModerationDismiss:
+		return nil
+	case ModerationHidePost:
+		if targetType != TargetPost {
+			return fmt.Errorf("%w: only posts can be hidden", ErrInvalidModerationAction)
+		}
+		return nil
+	case ModerationDeleteEvent:
+		if targetType != TargetEvent {
+			return fmt.Errorf("%w: only events can be deleted", ErrInvalidModerationAction)
+		}
+		return nil
+	}
+	return fmt.Errorf("%w: unknown action %q", ErrInvalidModerationAction, a)
+}
+
+// ReportStatus returns the status of the reports closed by the action
+func (a ModerationAction) ReportStatus() ReportStatus {
+	if a == ModerationDismiss {
+		return ReportStatusDismissed
+	}
+	return ReportStatusResolved
+}
+
+// Report is the complaint of the user about the post or the event, a user can have only one open report per target
+type Report struct {
+	ID          string           `json:"id"`
+	TargetType  TargetType       `json:"target_type"`
+	TargetId    string           `json:"target_id"`
+	ReporterId  int64            `json:"reporter_id"`
+	Reason      ReportReason     `json:"reason"`
+	Text        string           `json:"text,omitempty"`
+	Status      ReportStatus     `json:"status"`
+	Action      ModerationAction `json:"action,omitempty"`
+	ModeratorId int64            `json:"moderator_id,omitempty"`
+	Note        string           `json:"note,omitempty"`
+	CreatedAt   time.Time        `json:"created_at"`
+	ClosedAt    time.Time        `json:"closed_at,omitempty"`
+}
+
+func (r *Report) IsOpen() bool {
+	return r.Status == ReportStatusOpen
+}
+
+// Close records the admin action against the report
+func (r *Report) Close(action ModerationAction, moderatorId int64, note string, now time.Time) error {
+	if !r.IsOpen() {
+		return ErrReportClosed
+	}
+	if err := action.Validate(r.TargetType); err != nil {
+		return err
+	}
+
+	r.Status = action.ReportStatus()
+	r.Action = action
+	r.ModeratorId = moderatorId
+	r.Note = note
+	r.ClosedAt = now
+	return nil
+}
+
+// ModerationQueueItem groups the open reports of the target
+type ModerationQueueItem struct {
+	TargetType      TargetType             `json:"target_type"`
+	TargetId        string                 `json:"target_id"`
+	ReportsCount    int64                  `json:"reports_count"`
+	Reasons         map[ReportReason]int64 `json:"reasons"`
+	FirstReportedAt time.Time              `json:"first_reported_at"`
+	LastReportedAt  time.Time              `json:"last_reported_at"`
+}
+
+func ValidateReport(reason ReportReason, text string) error {
+	if !reason.IsValid() {
+		return fmt.Errorf("%w: unknown reason %q", ErrInvalidReport, reason)
+	}
+	if reason == ReportReasonOther && strings.TrimSpace(text) == "" {
+		return fmt.Errorf("%w: text is required for the other reason", ErrInvalidReport)
+	}
+	if utf8.RuneCountInString(text) > MaxReportTextLength {
+		return fmt.Errorf("%w: text is longer than %d characters", ErrInvalidReport, MaxReportTextLength)
+	}
+	return nil
+}
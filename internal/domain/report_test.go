@@ -0,0 +1,49 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateReport(t *testing.T) {
+	assert.NoError(t, ValidateReport(ReportReasonSpam, ""))
+	assert.NoError(t, ValidateReport(ReportReasonOther, "wrong club"))
+	assert.ErrorIs(t, ValidateReport(ReportReason("BORING"), ""), ErrInvalidReport)
+	assert.ErrorIs(t, ValidateReport(ReportReasonOther, "  "), ErrInvalidReport, "other reason needs the text")
+	assert.ErrorIs(t, ValidateReport(ReportReasonSpam, strings.Repeat("a", MaxReportTextLength+1)), ErrInvalidReport)
+}
+
+func TestModerationAction_Validate(t *testing.T) {
+	assert.NoError(t, ModerationDismiss.Validate(TargetPost))
+	assert.NoError(t, ModerationDismiss.Validate(TargetEvent))
+	assert.NoError(t, ModerationHidePost.Validate(TargetPost))
+	assert.NoError(t, ModerationDeleteEvent.Validate(TargetEvent))
+	assert.ErrorIs(t, ModerationHidePost.Validate(TargetEvent), ErrInvalidModerationAction)
+	assert.ErrorIs(t, ModerationDeleteEvent.Validate(TargetPost), ErrInvalidModerationAction)
+	assert.ErrorIs(t, ModerationAction("BAN").Validate(TargetPost), ErrInvalidModerationAction)
+}
+
+func TestReport_Close(t *testing.T) {
+	now := time.Now()
+
+	report := &Report{TargetType: TargetPost, Status: ReportStatusOpen}
+	require.NoError(t, report.Close(ModerationHidePost, 1, "offensive", now))
+	assert.Equal(t, ReportStatusResolved, report.Status)
+	assert.Equal(t, ModerationHidePost, report.Action)
+	assert.Equal(t, int64(1), report.ModeratorId)
+	assert.Equal(t, "offensive", report.Note)
+	assert.Equal(t, now, report.ClosedAt)
+	assert.ErrorIs(t, report.Close(ModerationDismiss, 1, "", now), ErrReportClosed)
+
+	report = &Report{TargetType: TargetEvent, Status: ReportStatusOpen}
+	require.NoError(t, report.Close(ModerationDismiss, 1, "", now))
+	assert.Equal(t, ReportStatusDismissed, report.Status)
+
+	report = &Report{TargetType: TargetEvent, Status: ReportStatusOpen}
+	assert.ErrorIs(t, report.Close(ModerationHidePost, 1, "", now), ErrInvalidModerationAction)
+	assert.True(t, report.IsOpen(), "the report is left open on invalid action")
+}
@@ -0,0 +1,84 @@
+package domain
+
+import (
+	"slices"
+	"time"
+)
+
+// PostContent is the editable content of the post which is tracked by the post revisions
+type PostContent struct {
+	Title         string
+	Description   string
+	Tags          []string
+	CoverImages   []CoverImage
+	AttachedFiles []File
+}
+
+// PostRevision records a single update of the post content.
+// Content holds the post content as it was before the update, so restoring the revision reverts the update.
+type PostRevision struct {
+	ID            string
+	PostId        string
+	EditorId      int64
+	CreatedAt     time.Time
+	ChangedFields []string
+	Content       PostContent
+	// RestoredFrom is the id of the restored revision when the update was made by restoring it
+	RestoredFrom string
+}
+
+func (p *Post) Content() PostContent {
+	return PostContent{
+		Title:         p.Title,
+		Description:   p.Description,
+		Tags:          p.Tags,
+		CoverImages:   p.CoverImages,
+		AttachedFiles: p.AttachedFiles,
+	}
+}
+
+func (p *Post) SetContent(content PostContent) {
+	p.Title = content.Title
+	p.Description = content.Description
+	p.Tags = content.Tags
+	p.CoverImages = content.CoverImages
+	p.AttachedFiles = content.AttachedFiles
+}
+
+// ChangedFields returns the names of the fields which differ in the other content, nil and empty lists are equal
+func (c PostContent) ChangedFields(other PostContent) []string {
+	var changed []string
+	if c.Title != other.Title {
+		changed = append(changed, "title")
+	}
+	if c.Description != other.Description {
+		changed = append(changed, "description")
+	}
+	if !slices.Equal(c.Tags, other.Tags) {
+		changed = append(changed, "tags")
+	}
+	if !slices.Equal(c.CoverImages, other.CoverImages) {
+		changed = append(changed, "cover_images")
+	}
+	if !slices.Equal(c.AttachedFiles, other.AttachedFiles) {
+		changed = append(changed, "attached_files")
+	}
+	return changed
+}
+
+// NewPostRevision creates the revision of the post update, it returns nil when the content has not changed
+func NewPostRevision(id string, before PostContent, after *Post, editorId int64, now time.Time) *PostRevision {
+	changed := before.ChangedFields(after.Content())
+	if len(changed) == 0 {
+		return nil
+	}
+
+	return &PostRevision{
+		ID:            id,
+		PostId:        after.ID,
+		EditorId:      editorId,
+		CreatedAt:     now,
+		ChangedFields: changed,
+		Content:       before,
+	}
+}
@@ -0,0 +1,62 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostContentChangedFields(t *testing.T) {
+	content := PostContent{
+		Title:       "Title",
+		Description: "Description",
+		Tags:        []string{"go"},
+		CoverImages: []CoverImage{{File: File{Name: "cover", Url: "url"}, Position: 1}},
+	}
+
+	assert.Empty(t, content.ChangedFields(content))
+	assert.Empty(t, PostContent{}.ChangedFields(PostContent{Tags: []string{}, AttachedFiles: []File{}}), "nil and empty lists are equal")
+
+	changed := content
+	changed.Title = "New title"
+	changed.Tags = []string{"go", "mongo"}
+	changed.AttachedFiles = []File{{Name: "file"}}
+	assert.Equal(t, []string{"title", "tags", "attached_files"}, content.ChangedFields(changed))
+}
+
+func TestNewPostRevision(t *testing.T) {
+	now := time.Now()
+	post := &Post{ID: "post-id", Title: "Old", Description: "Description"}
+	before := post.Content()
+
+	assert.Nil(t, NewPostRevision("revision-id", before, post, 1, now), "unchanged content has no revision")
+
+	post.Title = "New"
+	revision := NewPostRevision("revision-id", before, post, 1, now)
+	require.NotNil(t, revision)
+	assert.Equal(t, "post-id", revision.PostId)
+	assert.Equal(t, int64(1), revision.EditorId)
+	assert.Equal(t, []string{"title"}, revision.ChangedFields)
+	assert.Equal(t, "Old", revision.Content.Title)
+
+	post.SetContent(revision.Content)
+	assert.Equal(t, before, post.Content())
+}
+
+func TestPostCanBeRestored(t *testing.T) {
+	now := time.Now()
+	window := 24 * time.Hour
+
+	post := Post{}
+	assert.False(t, post.IsDeleted())
+	assert.False(t, post.CanBeRestored(now, window))
+
+	post.DeletedAt = now.Add(-time.Hour)
+	assert.True(t, post.IsDeleted())
+	assert.True(t, post.CanBeRestored(now, window))
+
+	post.DeletedAt = now.Add(-2 * window)
+	assert.False(t, post.CanBeRestored(now, window))
+}
@@ -0,0 +1,94 @@
+package domain
+
+import "slices"
+
+type OrganizerRole string
+type EventPermission string
+
+const (
+	OrganizerRoleCoOwner      OrganizerRole = "CO_OWNER"
+	OrganizerRoleEditor       OrganizerRole = "EDITOR"
+	OrganizerRoleModerator    OrganizerRole = "MODERATOR"
+	OrganizerRoleCheckInStaff OrganizerRole = "CHECK_IN_STAFF"
+	OrganizerRoleViewer       OrganizerRole = "VIEWER"
+
+	DefaultOrganizerRole = OrganizerRoleModerator
+
+	EventPermissionUpdateEvent      EventPermission = "UPDATE_EVENT"
+	EventPermissionKickParticipant  EventPermission = "KICK_PARTICIPANT"
+	EventPermissionBanParticipant   EventPermission = "BAN_PARTICIPANT"
+	EventPermissionInviteOrganizer  EventPermission = "INVITE_ORGANIZER"
+	EventPermissionManageOrganizers EventPermission = "MANAGE_ORGANIZERS"
+	EventPermissionInviteClub       EventPermission = "INVITE_CLUB"
+	EventPermissionManageClubs      EventPermission = "MANAGE_CLUBS"
+	EventPermissionModerateComments EventPermission = "MODERATE_COMMENTS"
+)
+
+/*
+rolePermissions is the permission matrix of the event organizers.
+
+	The event owner has every permission regardless of the role.
+	Organizers without a role (created before roles were introduced) get the DefaultOrganizerRole permissions.
+*/
+var rolePermissions = map[OrganizerRole]map[EventPermission]bool{
+	OrganizerRoleCoOwner: {
+		EventPermissionUpdateEvent:      true,
+		EventPermissionKickParticipant:  true,
+		EventPermissionBanParticipant:   true,
+		EventPermissionInviteOrganizer:  true,
+		EventPermissionManageOrganizers: true,
+		EventPermissionInviteClub:       true,
+		EventPermissionManageClubs:      true,
+		EventPermissionModerateComments: true,
+	},
+	OrganizerRoleEditor: {
+		EventPermissionUpdateEvent:     true,
+		EventPermissionInviteOrganizer: true,
+	},
+	OrganizerRoleModerator: {
+		EventPermissionKickParticipant:  true,
+		EventPermissionBanParticipant:   true,
+		EventPermissionInviteOrganizer:  true,
+		EventPermissionModerateComments: true,
+	},
+	// there is no check-in flow yet, the staff only sees the event like the viewer
+	OrganizerRoleCheckInStaff: {},
+	OrganizerRoleViewer:       {},
+}
+
+func (r OrganizerRole) String() string {
+	return string(r)
+}
+
+func (r OrganizerRole) IsValid() bool {
+	_, ok := rolePermissions[r]
+	return ok
+}
+
+func (r OrganizerRole) HasPermission(permission EventPermission) bool {
+	if r == "" {
+		r = DefaultOrganizerRole
+	}
+	return rolePermissions[r][permission]
+}
+
+// Permissions returns the permissions the role grants, the empty role grants the DefaultOrganizerRole permissions
+func (r OrganizerRole) Permissions() []EventPermission {
+	if r == "" {
+		r = DefaultOrganizerRole
+	}
+
+	permissions := make([]EventPermission, 0, len(rolePermissions[r]))
+	for permission, granted := range rolePermissions[r] {
+		if granted {
+			permissions = append(permissions, permission)
+		}
+	}
+	slices.Sort(permissions)
+
+	return permissions
+}
+
+func (p EventPermission) String() string {
+	return string(p)
+}
@@ -0,0 +1,66 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrganizerRoleIsValid(t *testing.T) {
+	assert.True(t, OrganizerRoleCoOwner.IsValid())
+	assert.True(t, OrganizerRoleViewer.IsValid())
+	assert.False(t, OrganizerRole("ADMIN").IsValid())
+	assert.False(t, OrganizerRole("").IsValid())
+}
+
+func TestOrganizerRoleHasPermission(t *testing.T) {
+	assert.True(t, OrganizerRoleCoOwner.HasPermission(EventPermissionManageOrganizers))
+	assert.True(t, OrganizerRoleEditor.HasPermission(EventPermissionUpdateEvent))
+	assert.False(t, OrganizerRoleEditor.HasPermission(EventPermissionBanParticipant))
+	assert.False(t, OrganizerRoleCheckInStaff.HasPermission(EventPermissionUpdateEvent))
+	assert.False(t, OrganizerRoleViewer.HasPermission(EventPermissionInviteOrganizer))
+
+	// empty role falls back to the default one
+	assert.Equal(t,
+		DefaultOrganizerRole.HasPermission(EventPermissionKickParticipant),
+		OrganizerRole("").HasPermission(EventPermissionKickParticipant),
+	)
+}
+
+func TestOrganizerRolePermissions(t *testing.T) {
+	assert.Equal(t, []EventPermission{EventPermissionInviteOrganizer, EventPermissionUpdateEvent}, OrganizerRoleEditor.Permissions())
+	assert.Empty(t, OrganizerRoleViewer.Permissions())
+	assert.Equal(t, DefaultOrganizerRole.Permissions(), OrganizerRole("").Permissions())
+}
+
+func TestEventHasPermission(t *testing.T) {
+	event := Event{
+		OwnerId: 1,
+		Organizers: []Organizer{
+			{User: User{ID: 2}, Role: OrganizerRoleViewer},
+			{User: User{ID: 3}, Role: OrganizerRoleEditor},
+		},
+	}
+
+	assert.True(t, event.HasPermission(1, EventPermissionManageClubs))
+	assert.False(t, event.HasPermission(2, EventPermissionUpdateEvent))
+	assert.True(t, event.HasPermission(3, EventPermissionUpdateEvent))
+	assert.False(t, event.HasPermission(4, EventPermissionUpdateEvent))
+}
+
+func TestEventChangeOrganizerRole(t *testing.T) {
+	event := Event{
+		OwnerId: 1,
+		Organizers: []Organizer{
+			{User: User{ID: 1}, Role: OrganizerRoleCoOwner},
+			{User: User{ID: 2}, Role: OrganizerRoleViewer},
+		},
+	}
+
+	assert.NoError(t, event.ChangeOrganizerRole(2, OrganizerRoleEditor))
+	assert.Equal(t, OrganizerRoleEditor, event.GetOrganizerById(2).Role)
+
+	assert.ErrorIs(t, event.ChangeOrganizerRole(2, "ADMIN"), ErrInvalidOrganizerRole)
+	assert.ErrorIs(t, event.ChangeOrganizerRole(1, OrganizerRoleViewer), ErrUserIsEventOwner)
+	assert.ErrorIs(t, event.ChangeOrganizerRole(3, OrganizerRoleViewer), ErrOrganizerNotFound)
+}
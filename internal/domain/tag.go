@@ -0,0 +1,98 @@
+package domain
+
+import (
+	"strings"
+	"time"
+	"unicode"
+)
+
+// MaxTagSuggestions limits the number of tags returned by the autocomplete
+const MaxTagSuggestions = 10
+
+// Tag is the registry entry of the canonical tag, the slug is its id and the aliases are the merged tags
+type Tag struct {
+	Slug       string    `json:"slug"`
+	Name       string    `json:"name"`
+	Aliases    []string  `json:"aliases,omitempty"`
+	UsageCount int64     `json:"usage_count"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+/*
+NormalizeTag returns the slug of the tag: lower case letters and digits separated by single dashes.
+
+	Dots and apostrophes are dropped, so "A.I." and "AI" are the same tag,
+	the other non letter characters separate the words: "Machine_Learning " is "machine-learning".
+*/
+func NormalizeTag(tag string) string {
+	var b strings.Builder
+	b.Grow(len(tag))
+
+	pendingDash := false
+	for _, r := range strings.ToLower(tag) {
+		switch {
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			if pendingDash && b.Len() > 0 {
+				b.WriteByte('-')
+			}
+			pendingDash = false
+			b.WriteRune(r)
+		case r == '.' || r == '\'' || r == '’':
+			// dropped without separating the words
+		default:
+			pendingDash = true
+		}
+	}
+
+	return b.String()
+}
+
+// NormalizeTags returns the slugs of the tags in their order, empty and duplicate slugs are dropped
+func NormalizeTags(tags []string) []string {
+	if tags == nil {
+		return nil
+	}
+
+	seen := make(map[string]bool, len(tags))
+	slugs := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		slug := NormalizeTag(tag)
+		if slug == "" || seen[slug] {
+			continue
+		}
+		seen[slug] = true
+		slugs = append(slugs, slug)
+	}
+	return slugs
+}
+
+// TagUsageDelta returns the usage count changes of the tags when the previous tags are replaced by the current ones
+func TagUsageDelta(previous, current []string) map[string]int64 {
+	delta := make(map[string]int64)
+	for _, tag := range previous {
+		delta[tag]--
+	}
+	for _, tag := range current {
+		delta[tag]++
+	}
+	for tag, d := range delta {
+		if d == 0 {
+			delete(delta, tag)
+		}
+	}
+	return delta
+}
+
+// CanonicalTags normalizes the tags and replaces the merged ones by the canonical tags of their aliases
+func CanonicalTags(tags []string, canonical map[string]string) []string {
+	slugs := NormalizeTags(tags)
+	for i, slug := range slugs {
+		if tag, ok := canonical[slug]; ok {
+			slugs[i] = tag
+		}
+	}
+
+	// two aliases of the same tag become duplicates, the slugs are already normalized
+	return NormalizeTags(slugs)
+}
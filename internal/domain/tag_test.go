@@ -0,0 +1,50 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeTag(t *testing.T) {
+	tests := []struct {
+		tag      string
+		expected string
+	}{
+		{tag: "AI", expected: "ai"},
+		{tag: "ai ", expected: "ai"},
+		{tag: "A.I.", expected: "ai"},
+		{tag: "artificial-intelligence", expected: "artificial-intelligence"},
+		{tag: "  Machine_Learning  ", expected: "machine-learning"},
+		{tag: "C++ / Go", expected: "c-go"},
+		{tag: "Robot's club", expected: "robots-club"},
+		{tag: "Қазақ тілі", expected: "қазақ-тілі"},
+		{tag: "!!", expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.tag, func(t *testing.T) {
+			assert.Equal(t, tt.expected, NormalizeTag(tt.tag))
+		})
+	}
+}
+
+func TestNormalizeTags(t *testing.T) {
+	assert.Nil(t, NormalizeTags(nil))
+	assert.Equal(t, []string{}, NormalizeTags([]string{"--"}))
+	assert.Equal(t, []string{"ai", "go"}, NormalizeTags([]string{"AI", "Go", "a.i.", "", "go "}))
+}
+
+func TestTagUsageDelta(t *testing.T) {
+	assert.Empty(t, TagUsageDelta([]string{"ai", "go"}, []string{"go", "ai"}))
+	assert.Equal(t, map[string]int64{"ai": 1}, TagUsageDelta(nil, []string{"ai"}))
+	assert.Equal(t, map[string]int64{"ai": -1, "ml": 1}, TagUsageDelta([]string{"ai", "go"}, []string{"go", "ml"}))
+}
+
+func TestCanonicalTags(t *testing.T) {
+	canonical := map[string]string{"ml": "machine-learning", "deep-learning": "machine-learning"}
+
+	assert.Nil(t, CanonicalTags(nil, canonical))
+	assert.Equal(t, []string{"machine-learning", "go"}, CanonicalTags([]string{"ML", "Go", "Deep Learning"}, canonical))
+	assert.Equal(t, []string{"ai"}, CanonicalTags([]string{"A.I."}, nil))
+}
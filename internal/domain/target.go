@@ -0,0 +1,17 @@
+package domain
+
+// TargetType is the type of the document which comments and reactions are attached to
+type TargetType string
+
+const (
+	TargetPost  TargetType = "POST"
+	TargetEvent TargetType = "EVENT"
+)
+
+func (t TargetType) String() string {
+	return string(t)
+}
+
+func (t TargetType) IsValid() bool {
+	return t == TargetPost || t == TargetEvent
+}
@@ -0,0 +1,13 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTargetType_IsValid(t *testing.T) {
+	assert.True(t, TargetPost.IsValid())
+	assert.True(t, TargetEvent.IsValid())
+	assert.False(t, TargetType("CLUB").IsValid())
+}
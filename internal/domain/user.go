@@ -28,8 +28,9 @@ type User struct {
 
 type Organizer struct {
 	User    `json:",inline"`
-	ClubId  int64 `json:"club_id"`
-	ByWhoId int64 `json:"by_who_id"`
+	ClubId  int64         `json:"club_id"`
+	ByWhoId int64         `json:"by_who_id"`
+	Role    OrganizerRole `json:"role"`
 }
 
 type Participant struct {
@@ -44,6 +45,7 @@ func (u User) ToOrganizer(clubId, byWhoId int64) Organizer {
 		User:    u,
 		ClubId:  clubId,
 		ByWhoId: byWhoId,
+		Role:    DefaultOrganizerRole,
 	}
 }
 
@@ -82,6 +84,10 @@ func (o Organizer) IsByWho(userId int64) bool {
 	return o.ByWhoId == userId
 }
 
+func (o Organizer) HasPermission(permission EventPermission) bool {
+	return o.Role.HasPermission(permission)
+}
+
 func OrganizersToProto(organizers []Organizer) []*eventv1.OrganizerObject {
 	convertedOrganizers := make([]*eventv1.OrganizerObject, len(organizers))
 	for i, organizer := range organizers {
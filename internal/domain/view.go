@@ -0,0 +1,77 @@
+package domain
+
+import "time"
+
+// ViewSource is the place the post was opened from
+type ViewSource string
+
+const (
+	ViewSourceFeed     ViewSource = "FEED"
+	ViewSourceDirect   ViewSource = "DIRECT"
+	ViewSourceClubPage ViewSource = "CLUB_PAGE"
+)
+
+func (s ViewSource) String() string {
+	return string(s)
+}
+
+func (s ViewSource) IsValid() bool {
+	switch s {
+	case ViewSourceFeed, ViewSourceDirect, ViewSourceClubPage:
+		return true
+	}
+	return false
+}
+
+// PostView is a single read of the post, UserId is zero for the anonymous views
+type PostView struct {
+	PostId   string
+	UserId   int64
+	Source   ViewSource
+	ViewedAt time.Time
+}
+
+// Day returns the UTC day of the view, the views of the same user are counted once per day
+func (v PostView) Day() time.Time {
+	return ViewDay(v.ViewedAt)
+}
+
+func ViewDay(t time.Time) time.Time {
+	return t.UTC().Truncate(24 * time.Hour)
+}
+
+// PostDailyViews contains the views of the post during the day, Views are counted once per user per day
+type PostDailyViews struct {
+	Day            time.Time `json:"day"`
+	Views          int64     `json:"views"`
+	AnonymousViews int64     `json:"anonymous_views"`
+}
+
+type PostStats struct {
+	PostId         string               `json:"post_id"`
+	From           time.Time            `json:"from"`
+	To             time.Time            `json:"to"`
+	TotalViews     int64                `json:"total_views"`
+	AnonymousViews int64                `json:"anonymous_views"`
+	UniqueViewers  int64                `json:"unique_viewers"`
+	Daily          []PostDailyViews     `json:"daily"`
+	Sources        map[ViewSource]int64 `json:"sources"`
+}
+
+// NewPostStats sums the daily views of the post, sources are the views counted by the view source
+func NewPostStats(postId string, from, to time.Time, daily []PostDailyViews, sources map[ViewSource]int64, uniqueViewers int64) *PostStats {
+	stats := &PostStats{
+		PostId:        postId,
+		From:          from,
+		To:            to,
+		UniqueViewers: uniqueViewers,
+		Daily:         daily,
+		Sources:       sources,
+	}
+	for _, day := range daily {
+		stats.TotalViews += day.Views + day.AnonymousViews
+		stats.AnonymousViews += day.AnonymousViews
+	}
+
+	return stats
+}
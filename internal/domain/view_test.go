@@ -0,0 +1,46 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestViewSourceIsValid(t *testing.T) {
+	assert.True(t, ViewSourceFeed.IsValid())
+	assert.True(t, ViewSourceDirect.IsValid())
+	assert.True(t, ViewSourceClubPage.IsValid())
+	assert.False(t, ViewSource("").IsValid())
+	assert.False(t, ViewSource("feed").IsValid())
+}
+
+func TestPostViewDay(t *testing.T) {
+	almaty := time.FixedZone("Almaty", 5*60*60)
+	view := PostView{ViewedAt: time.Date(2024, 5, 2, 3, 30, 0, 0, almaty)}
+
+	assert.Equal(t, time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), view.Day(), "the day is taken in UTC")
+	assert.Equal(t, view.Day(), ViewDay(view.ViewedAt.Add(time.Hour)))
+}
+
+func TestNewPostStats(t *testing.T) {
+	from := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 2)
+	daily := []PostDailyViews{
+		{Day: from, Views: 3, AnonymousViews: 1},
+		{Day: from.AddDate(0, 0, 1), Views: 2, AnonymousViews: 4},
+	}
+	sources := map[ViewSource]int64{ViewSourceFeed: 6, ViewSourceDirect: 4}
+
+	stats := NewPostStats("post", from, to, daily, sources, 4)
+	assert.Equal(t, "post", stats.PostId)
+	assert.Equal(t, int64(10), stats.TotalViews)
+	assert.Equal(t, int64(5), stats.AnonymousViews)
+	assert.Equal(t, int64(4), stats.UniqueViewers)
+	assert.Equal(t, daily, stats.Daily)
+	assert.Equal(t, sources, stats.Sources)
+
+	empty := NewPostStats("post", from, to, nil, nil, 0)
+	assert.Zero(t, empty.TotalViews)
+	assert.Zero(t, empty.AnonymousViews)
+}
@@ -52,12 +52,18 @@ func (s serverApi) ListEvents(ctx context.Context, req *eventv1.ListEventsReques
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	filters := domain.ProtoToFilers(req)
+	filters, err := domain.ProtoToFilers(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	events, pagination, err := s.info.ListEvents(ctx, filters)
 	if err != nil {
 		switch {
 		case errors.Is(err, eventservice.ErrEventNotFound):
 			return nil, status.Error(codes.NotFound, err.Error())
+		case errors.Is(err, eventservice.ErrInvalidCursor), errors.Is(err, eventservice.ErrEventInvalidFields):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
 		default:
 			return nil, status.Error(codes.Internal, "internal error")
 		}
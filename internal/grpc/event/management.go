@@ -165,10 +165,13 @@ func handleError(err error) error {
 	case errors.Is(err, eventservice.ErrEventNotFound),
 		errors.Is(err, eventservice.ErrClubNotExists),
 		errors.Is(err, eventservice.ErrParticipantNotFound),
-		errors.Is(err, eventservice.ErrBanRecordNotFound):
+		errors.Is(err, eventservice.ErrBanRecordNotFound),
+		errors.Is(err, eventservice.ErrOrganizerNotFound),
+		errors.Is(err, eventservice.ErrOwnershipTransferNotFound):
 		return status.Error(codes.NotFound, err.Error())
 	case errors.Is(err, eventservice.ErrInvalidID),
-		errors.Is(err, eventservice.ErrEventInvalidFields):
+		errors.Is(err, eventservice.ErrEventInvalidFields),
+		errors.Is(err, eventservice.ErrInvalidCursor):
 		return status.Error(codes.InvalidArgument, err.Error())
 	case errors.Is(err, eventservice.ErrUserIsNotEventOwner),
 		errors.Is(err, eventservice.ErrUserIsFromAnotherClub),
@@ -182,7 +185,8 @@ func handleError(err error) error {
 	case errors.Is(err, eventservice.ErrEventIsFull),
 		errors.Is(err, eventservice.ErrAlreadyParticipating),
 		errors.Is(err, eventservice.ErrInvalidEventStatus),
-		errors.Is(err, eventservice.ErrUserIsBanned):
+		errors.Is(err, eventservice.ErrUserIsBanned),
+		errors.Is(err, eventservice.ErrUserIsEventOwner):
 		return status.Error(codes.FailedPrecondition, err.Error())
 	default:
 		return status.Error(codes.Internal, "internal error")
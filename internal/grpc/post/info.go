@@ -11,7 +11,7 @@ import (
 )
 
 type InfoService interface {
-	GetPost(ctx context.Context, postId string, userId int64) (*domain.Post, error)
+	GetPost(ctx context.Context, postId string, userId int64, source domain.ViewSource) (*dtos.GetPost, error)
 	ListPosts(ctx context.Context, filter *dtos.ListPostsRequest) ([]domain.Post, *domain.PaginationMetadata, error)
 }
 
@@ -21,12 +21,13 @@ func (s serverApi) GetPost(ctx context.Context, req *postv1.GetPostRequest) (*po
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	post, err := s.info.GetPost(ctx, req.GetId(), req.GetUserId())
+	// the request has no view source, the posts opened through the API are counted as direct views
+	dto, err := s.info.GetPost(ctx, req.GetId(), req.GetUserId(), domain.ViewSourceDirect)
 	if err != nil {
 		return nil, handleServiceError(err)
 	}
 
-	return domain.PostToPb(post), nil
+	return domain.PostToPb(&dto.Post), nil
 }
 func (s serverApi) ListPosts(ctx context.Context, req *postv1.ListPostsRequest) (*postv1.ListPostsResponse, error) {
 	err := validate.ListPostsRequest(req)
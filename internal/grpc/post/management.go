@@ -61,11 +61,29 @@ func (s serverApi) DeletePost(ctx context.Context, req *postv1.ActionRequest) (*
 }
 
 func (s serverApi) HidePost(ctx context.Context, req *postv1.ActionRequest) (*postv1.PostObject, error) {
-	//TODO implement me
-	panic("implement me")
+	err := validate.PostActionRequest(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	post, err := s.management.HidePost(ctx, dtos.ToActionRequest(req))
+	if err != nil {
+		return nil, handleServiceError(err)
+	}
+
+	return domain.PostToPb(post), nil
 }
 
 func (s serverApi) UnhidePost(ctx context.Context, req *postv1.ActionRequest) (*postv1.PostObject, error) {
-	//TODO implement me
-	panic("implement me")
+	err := validate.PostActionRequest(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	post, err := s.management.UnhidePost(ctx, dtos.ToActionRequest(req))
+	if err != nil {
+		return nil, handleServiceError(err)
+	}
+
+	return domain.PostToPb(post), nil
 }
@@ -38,7 +38,7 @@ func NewServices(
 
 func handleServiceError(err error) error {
 	switch {
-	case errors.Is(err, postservice.ErrPostNotFound), errors.Is(err, postservice.ErrClubNotFound):
+	case errors.Is(err, postservice.ErrPostNotFound), errors.Is(err, postservice.ErrClubNotFound), errors.Is(err, postservice.ErrRevisionNotFound):
 		return status.Error(codes.NotFound, err.Error())
 	case errors.Is(err, postservice.ErrPermissionDenied):
 		return status.Error(codes.PermissionDenied, err.Error())
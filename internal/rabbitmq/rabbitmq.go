@@ -13,10 +13,24 @@ import (
 const (
 	ClubExchangeName           = "club-exchange"
 	UserExchangeName           = "user-exchange"
+	PostsExchangeName          = "posts-exchange"
 	UserEventsQueue            = "user-events-posts-queue"
 	ClubEventsQueue            = "club-events-posts-queue"
 	UserUpdatedEventRoutingKey = "user.event.updated"
 	ClubUpdatedEventRoutingKey = "club.event.updated"
+
+	CollaboratorLeftRoutingKey = "event.collaborator.left"
+
+	OrganizerInviteCreatedRoutingKey  = "invite.organizer.created"
+	OrganizerInviteAcceptedRoutingKey = "invite.organizer.accepted"
+	OrganizerInviteRejectedRoutingKey = "invite.organizer.rejected"
+	OrganizerInviteRevokedRoutingKey  = "invite.organizer.revoked"
+	ClubInviteCreatedRoutingKey       = "invite.club.created"
+	ClubInviteAcceptedRoutingKey      = "invite.club.accepted"
+	ClubInviteRejectedRoutingKey      = "invite.club.rejected"
+	ClubInviteRevokedRoutingKey       = "invite.club.revoked"
+
+	MentionCreatedRoutingKey = "mention.created"
 )
 
 type Handler func(msg amqp.Delivery) error
@@ -202,6 +216,18 @@ func declareExchanges(ch *amqp.Channel) error {
 	if err != nil {
 		return err
 	}
+	err = ch.ExchangeDeclare(
+		PostsExchangeName,
+		"topic",
+		true,
+		false,
+		false,
+		false,
+		nil,
+	)
+	if err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -0,0 +1,336 @@
+package commentservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	clubv1 "github.com/ARUMANDESU/uniclubs-protos/gen/go/club"
+	"github.com/arumandesu/uniclubs-posts-service/internal/client/club"
+	userclient "github.com/arumandesu/uniclubs-posts-service/internal/client/user"
+	"github.com/arumandesu/uniclubs-posts-service/internal/domain"
+	dtos "github.com/arumandesu/uniclubs-posts-service/internal/domain/dto"
+	postservice "github.com/arumandesu/uniclubs-posts-service/internal/services/post"
+	"github.com/arumandesu/uniclubs-posts-service/internal/storage"
+	"github.com/arumandesu/uniclubs-posts-service/pkg/logger"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"log/slog"
+	"time"
+)
+
+var (
+	ErrCommentNotFound         = errors.New("comment not found")
+	ErrTargetNotFound          = errors.New("comment target not found")
+	ErrUserNotFound            = errors.New("user not found")
+	ErrPermissionDenied        = errors.New("permission denied")
+	ErrInvalidArg              = errors.New("invalid argument")
+	ErrInvalidID               = errors.New("invalid id")
+	ErrCommentDeleted          = errors.New("comment is deleted")
+	ErrOptimisticLockingFailed = errors.New("optimistic locking failed")
+)
+
+type Service struct {
+	log           *slog.Logger
+	storage       CommentStorage
+	postProvider  PostProvider
+	eventProvider EventProvider
+	userProvider  UserProvider
+	clubProvider  ClubProvider
+}
+
+type CommentStorage interface {
+	CreateComment(ctx context.Context, comment *domain.Comment) (*domain.Comment, error)
+	GetCommentById(ctx context.Context, commentId string) (*domain.Comment, error)
+	UpdateComment(ctx context.Context, comment *domain.Comment) (*domain.Comment, error)
+	ListComments(ctx context.Context, dto *dtos.ListComments) ([]domain.Comment, *domain.PaginationMetadata, error)
+}
+
+type PostProvider interface {
+	GetPostById(ctx context.Context, postId string) (*domain.Post, error)
+}
+
+type EventProvider interface {
+	GetEvent(ctx context.Context, eventId string) (*domain.Event, error)
+}
+
+type UserProvider interface {
+	GetUserById(ctx context.Context, userId int64) (*domain.User, error)
+}
+
+type ClubProvider interface {
+	HasPermission(ctx context.Context, userId, clubId int64, permission clubv1.Permission) (bool, error)
+	IsClubMember(ctx context.Context, userId, clubId int64) (bool, error)
+}
+
+func New(
+	log *slog.Logger,
+	storage CommentStorage,
+	postProvider PostProvider,
+	eventProvider EventProvider,
+	userProvider UserProvider,
+	clubProvider ClubProvider,
+) *Service {
+	return &Service{
+		log:           log,
+		storage:       storage,
+		postProvider:  postProvider,
+		eventProvider: eventProvider,
+		userProvider:  userProvider,
+		clubProvider:  clubProvider,
+	}
+}
+
+func (s Service) CreateComment(ctx context.Context, dto *dtos.CreateComment) (*domain.Comment, error) {
+	const op = "services.comment.createComment"
+	log := s.log.With(slog.String("op", op))
+
+	if !dto.TargetType.IsValid() {
+		return nil, fmt.Errorf("%w: unknown target type %q", ErrInvalidArg, dto.TargetType)
+	}
+	if err := domain.ValidateCommentBody(dto.Body); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidArg, err)
+	}
+
+	if _, err := s.targetAccess(ctx, dto.TargetType, dto.TargetId, dto.UserId); err != nil {
+		return nil, handleError(log, "failed to get comment target", err)
+	}
+
+	// replies are allowed only one level deep, a reply to a reply is attached to the root comment
+	parentId := dto.ParentId
+	if parentId != "" {
+		parent, err := s.storage.GetCommentById(ctx, parentId)
+		if err != nil {
+			return nil, handleError(log, "failed to get parent comment", err)
+		}
+		if parent.TargetType != dto.TargetType || parent.TargetId != dto.TargetId {
+			return nil, fmt.Errorf("%w: parent comment belongs to another target", ErrInvalidArg)
+		}
+		if parent.IsReply() {
+			parentId = parent.ParentId
+		}
+	}
+
+	user, err := s.userProvider.GetUserById(ctx, dto.UserId)
+	if err != nil {
+		return nil, handleError(log, "failed to get user", err)
+	}
+
+	now := time.Now()
+	comment := &domain.Comment{
+		ID:         primitive.NewObjectID().Hex(),
+		TargetType: dto.TargetType,
+		TargetId:   dto.TargetId,
+		ParentId:   parentId,
+		User:       *user,
+		Body:       dto.Body,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+
+	comment, err = s.storage.CreateComment(ctx, comment)
+	if err != nil {
+		return nil, handleError(log, "failed to create comment", err)
+	}
+
+	return comment, nil
+}
+
+// GetComment returns the comment, hidden comments are visible only to the moderators
+func (s Service) GetComment(ctx context.Context, commentId string, userId int64) (*domain.Comment, error) {
+	const op = "services.comment.getComment"
+	log := s.log.With(slog.String("op", op))
+
+	comment, err := s.storage.GetCommentById(ctx, commentId)
+	if err != nil {
+		return nil, handleError(log, "failed to get comment", err)
+	}
+
+	canModerate, err := s.targetAccess(ctx, comment.TargetType, comment.TargetId, userId)
+	if err != nil {
+		return nil, handleError(log, "failed to get comment target", err)
+	}
+	if comment.Hidden && !comment.IsAuthor(userId) && !canModerate {
+		return nil, ErrCommentNotFound
+	}
+
+	return comment, nil
+}
+
+func (s Service) UpdateComment(ctx context.Context, dto *dtos.UpdateComment) (*domain.Comment, error) {
+	const op = "services.comment.updateComment"
+	log := s.log.With(slog.String("op", op))
+
+	comment, err := s.storage.GetCommentById(ctx, dto.CommentId)
+	if err != nil {
+		return nil, handleError(log, "failed to get comment", err)
+	}
+
+	if !comment.IsAuthor(dto.UserId) {
+		return nil, fmt.Errorf("%w: only the author can edit the comment", ErrPermissionDenied)
+	}
+	if _, err = s.targetAccess(ctx, comment.TargetType, comment.TargetId, dto.UserId); err != nil {
+		return nil, handleError(log, "failed to get comment target", err)
+	}
+
+	if err = comment.Edit(dto.Body); err != nil {
+		return nil, handleError(log, "failed to edit comment", err)
+	}
+
+	comment, err = s.storage.UpdateComment(ctx, comment)
+	if err != nil {
+		return nil, handleError(log, "failed to update comment", err)
+	}
+
+	return comment, nil
+}
+
+// DeleteComment soft deletes the comment, it can be done by the author or by the moderators
+func (s Service) DeleteComment(ctx context.Context, dto *dtos.CommentAction) (*domain.Comment, error) {
+	const op = "services.comment.deleteComment"
+	log := s.log.With(slog.String("op", op))
+
+	comment, err := s.storage.GetCommentById(ctx, dto.CommentId)
+	if err != nil {
+		return nil, handleError(log, "failed to get comment", err)
+	}
+
+	if !comment.IsAuthor(dto.UserId) {
+		canModerate, err := s.targetAccess(ctx, comment.TargetType, comment.TargetId, dto.UserId)
+		if err != nil {
+			return nil, handleError(log, "failed to check permission", err)
+		}
+		if !canModerate {
+			return nil, fmt.Errorf("%w: user %d can not delete the comment", ErrPermissionDenied, dto.UserId)
+		}
+	}
+
+	if err = comment.SoftDelete(dto.UserId); err != nil {
+		return nil, handleError(log, "failed to delete comment", err)
+	}
+
+	comment, err = s.storage.UpdateComment(ctx, comment)
+	if err != nil {
+		return nil, handleError(log, "failed to update comment", err)
+	}
+
+	return comment, nil
+}
+
+func (s Service) HideComment(ctx context.Context, dto *dtos.CommentAction) (*domain.Comment, error) {
+	return s.changeVisibility(ctx, dto, true)
+}
+
+func (s Service) UnhideComment(ctx context.Context, dto *dtos.CommentAction) (*domain.Comment, error) {
+	return s.changeVisibility(ctx, dto, false)
+}
+
+func (s Service) changeVisibility(ctx context.Context, dto *dtos.CommentAction, hide bool) (*domain.Comment, error) {
+	const op = "services.comment.changeVisibility"
+	log := s.log.With(slog.String("op", op))
+
+	comment, err := s.storage.GetCommentById(ctx, dto.CommentId)
+	if err != nil {
+		return nil, handleError(log, "failed to get comment", err)
+	}
+
+	canModerate, err := s.targetAccess(ctx, comment.TargetType, comment.TargetId, dto.UserId)
+	if err != nil {
+		return nil, handleError(log, "failed to check permission", err)
+	}
+	if !canModerate {
+		return nil, fmt.Errorf("%w: user %d can not moderate the comments", ErrPermissionDenied, dto.UserId)
+	}
+
+	if hide {
+		comment.Hide(dto.UserId)
+	} else {
+		comment.Unhide()
+	}
+
+	comment, err = s.storage.UpdateComment(ctx, comment)
+	if err != nil {
+		return nil, handleError(log, "failed to update comment", err)
+	}
+
+	return comment, nil
+}
+
+func (s Service) ListComments(ctx context.Context, dto *dtos.ListComments) ([]domain.Comment, *domain.PaginationMetadata, error) {
+	const op = "services.comment.listComments"
+	log := s.log.With(slog.String("op", op))
+
+	if !dto.TargetType.IsValid() {
+		return nil, nil, fmt.Errorf("%w: unknown target type %q", ErrInvalidArg, dto.TargetType)
+	}
+
+	canModerate, err := s.targetAccess(ctx, dto.TargetType, dto.TargetId, dto.UserId)
+	if err != nil {
+		return nil, nil, handleError(log, "failed to get comment target", err)
+	}
+	dto.IncludeHidden = canModerate
+
+	comments, metadata, err := s.storage.ListComments(ctx, dto)
+	if err != nil {
+		return nil, nil, handleError(log, "failed to list comments", err)
+	}
+
+	return comments, metadata, nil
+}
+
+/*
+targetAccess checks that the target is visible to the user and reports whether the user can hide and delete its comments.
+
+	Hidden, unpublished and restricted posts follow the club audience, the club post managers moderate the post comments.
+	Draft events are visible only to the organizers, the organizers with the moderation permission moderate the event comments.
+*/
+func (s Service) targetAccess(ctx context.Context, targetType domain.TargetType, targetId string, userId int64) (bool, error) {
+	switch targetType {
+	case domain.TargetPost:
+		post, err := s.postProvider.GetPostById(ctx, targetId)
+		if err != nil {
+			return false, err
+		}
+		audience, err := postservice.ClubAudience(ctx, s.clubProvider, userId, post.Club.ID)
+		if err != nil {
+			return false, err
+		}
+		if !post.IsReadableBy(audience) {
+			return false, ErrTargetNotFound
+		}
+		return audience.CanManage, nil
+	case domain.TargetEvent:
+		event, err := s.eventProvider.GetEvent(ctx, targetId)
+		if err != nil {
+			return false, err
+		}
+		if !event.IsVisibleTo(userId) {
+			return false, ErrTargetNotFound
+		}
+		return userId != 0 && event.HasPermission(userId, domain.EventPermissionModerateComments), nil
+	default:
+		return false, fmt.Errorf("%w: unknown target type %q", ErrInvalidArg, targetType)
+	}
+}
+
+func handleError(log *slog.Logger, msg string, err error) error {
+	switch {
+	case errors.Is(err, storage.ErrCommentNotFound):
+		return ErrCommentNotFound
+	case errors.Is(err, storage.ErrNotFound), errors.Is(err, storage.ErrEventNotFound), errors.Is(err, ErrTargetNotFound):
+		return ErrTargetNotFound
+	case errors.Is(err, storage.ErrInvalidID):
+		return ErrInvalidID
+	case errors.Is(err, storage.ErrOptimisticLockingFailed):
+		return ErrOptimisticLockingFailed
+	case errors.Is(err, userclient.ErrUserNotFound):
+		return ErrUserNotFound
+	case errors.Is(err, userclient.ErrInvalidArg), errors.Is(err, club.ErrInvalidArg), errors.Is(err, ErrInvalidArg):
+		return ErrInvalidArg
+	case errors.Is(err, domain.ErrInvalidCommentBody):
+		return fmt.Errorf("%w: %w", ErrInvalidArg, err)
+	case errors.Is(err, domain.ErrCommentDeleted):
+		return ErrCommentDeleted
+	default:
+		log.Error(msg, logger.Err(err))
+		return err
+	}
+}
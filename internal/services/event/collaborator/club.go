@@ -4,8 +4,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	clubv1 "github.com/ARUMANDESU/uniclubs-protos/gen/go/club"
+	"github.com/arumandesu/uniclubs-posts-service/internal/client/club"
 	"github.com/arumandesu/uniclubs-posts-service/internal/domain"
 	"github.com/arumandesu/uniclubs-posts-service/internal/domain/dto"
+	"github.com/arumandesu/uniclubs-posts-service/internal/rabbitmq"
 	"github.com/arumandesu/uniclubs-posts-service/internal/services/event"
 	"github.com/arumandesu/uniclubs-posts-service/internal/storage"
 	"github.com/arumandesu/uniclubs-posts-service/pkg/logger"
@@ -31,7 +34,7 @@ func (s Service) SendJoinRequestToClub(ctx context.Context, dto *dtos.SendJoinRe
 		}
 	}
 
-	if !event.IsOwner(dto.UserId) {
+	if !event.HasPermission(dto.UserId, domain.EventPermissionInviteClub) {
 		return nil, eventservice.ErrPermissionsDenied
 	}
 
@@ -67,6 +70,8 @@ func (s Service) SendJoinRequestToClub(ctx context.Context, dto *dtos.SendJoinRe
 		}
 	}
 
+	s.publish(ctx, log, rabbitmq.ClubInviteCreatedRoutingKey, newClubInviteMessage(*invite, event))
+
 	return event, nil
 }
 
@@ -161,6 +166,9 @@ func (s Service) AcceptClubJoinRequest(ctx context.Context, dto *dtos.AcceptJoin
 			}
 		}
 	}
+
+	s.publish(ctx, log, rabbitmq.ClubInviteAcceptedRoutingKey, newClubInviteMessage(*invite, event))
+
 	return *event, nil
 
 }
@@ -195,6 +203,8 @@ func (s Service) RejectClubJoinRequest(ctx context.Context, inviteId string, clu
 		return domain.Event{}, err
 	}
 
+	s.publish(ctx, log, rabbitmq.ClubInviteRejectedRoutingKey, newClubInviteMessage(*invite, nil))
+
 	return domain.Event{}, nil
 }
 
@@ -215,7 +225,7 @@ func (s Service) KickClub(ctx context.Context, eventId string, userId, clubId in
 		}
 	}
 
-	if !event.IsOwner(userId) {
+	if !event.HasPermission(userId, domain.EventPermissionManageClubs) {
 		return nil, eventservice.ErrPermissionsDenied
 	}
 
@@ -301,7 +311,7 @@ func (s Service) RevokeInviteClub(ctx context.Context, inviteId string, userId i
 		}
 	}
 
-	if !event.IsOwner(userId) {
+	if !event.HasPermission(userId, domain.EventPermissionInviteClub) {
 		return eventservice.ErrPermissionsDenied
 	}
 
@@ -314,5 +324,87 @@ func (s Service) RevokeInviteClub(ctx context.Context, inviteId string, userId i
 		return err
 	}
 
+	s.publish(ctx, log, rabbitmq.ClubInviteRevokedRoutingKey, newClubInviteMessage(*invite, event))
+
 	return nil
 }
+
+// LeaveEvent removes the collaborator club with its organizers from the event and notifies the owner club
+func (s Service) LeaveEvent(ctx context.Context, dto *dtos.LeaveEvent) (*domain.Event, error) {
+	const op = "services.event.collaborator.leaveEvent"
+	log := s.log.With(slog.String("op", op))
+
+	hasPermission, err := s.clubProvider.HasPermission(ctx, dto.UserId, dto.ClubId, clubv1.Permission_PERMISSION_MANAGE_EVENTS)
+	if err != nil {
+		switch {
+		case errors.Is(err, club.ErrClubNotFound):
+			return nil, eventservice.ErrClubNotExists
+		default:
+			log.Error("failed to check club permission", logger.Err(err))
+			return nil, err
+		}
+	}
+	if !hasPermission {
+		return nil, eventservice.ErrPermissionsDenied
+	}
+
+	event, err := s.eventStorage.GetEvent(ctx, dto.EventId)
+	if err != nil {
+		switch {
+		case errors.Is(err, storage.ErrEventNotFound):
+			return nil, eventservice.ErrEventNotFound
+		case errors.Is(err, storage.ErrInvalidID):
+			return nil, eventservice.ErrInvalidID
+		default:
+			log.Error("failed to get event", logger.Err(err))
+			return nil, err
+		}
+	}
+
+	collaborator := event.GetCollaboratorById(dto.ClubId)
+	if collaborator == nil {
+		return nil, eventservice.ErrCollaboratorNotFound
+	}
+
+	err = event.RemoveCollaborator(dto.ClubId)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrClubIsEventOwner):
+			return nil, eventservice.ErrClubIsEventOwner
+		case errors.Is(err, domain.ErrCollaboratorsEmpty), errors.Is(err, domain.ErrCollaboratorNotFound):
+			return nil, eventservice.ErrCollaboratorNotFound
+		default:
+			log.Error("failed to remove collaborator", logger.Err(err))
+			return nil, err
+		}
+	}
+
+	err = event.RemoveOrganizersByClubId(dto.ClubId)
+	if err != nil && !errors.Is(err, domain.ErrOrganizersEmpty) {
+		log.Error(fmt.Sprintf("failed to remove organizers with club id: %d", dto.ClubId), logger.Err(err))
+		return nil, err
+	}
+
+	updateCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	event, err = s.eventStorage.UpdateEvent(updateCtx, event)
+	if err != nil {
+		switch {
+		case errors.Is(err, storage.ErrOptimisticLockingFailed):
+			return nil, eventservice.ErrEventUpdateConflict
+		default:
+			log.Error("failed to update event", logger.Err(err))
+			return nil, err
+		}
+	}
+
+	s.publish(ctx, log, rabbitmq.CollaboratorLeftRoutingKey, collaboratorLeftMessage{
+		EventId:     event.ID,
+		EventTitle:  event.Title,
+		OwnerClubId: event.ClubId,
+		Club:        *collaborator,
+		UserId:      dto.UserId,
+	})
+
+	return event, nil
+}
@@ -0,0 +1,151 @@
+// Code generated by mockery v2.43.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "github.com/arumandesu/uniclubs-posts-service/internal/domain"
+	dtos "github.com/arumandesu/uniclubs-posts-service/internal/domain/dto"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ClubInviteStorage is an autogenerated mock type for the ClubInviteStorage type
+type ClubInviteStorage struct {
+	mock.Mock
+}
+
+// CreateJoinRequestToClub provides a mock function with given fields: ctx, dto
+func (_m *ClubInviteStorage) CreateJoinRequestToClub(ctx context.Context, dto *dtos.SendJoinRequestToClub) (*domain.Invite, error) {
+	ret := _m.Called(ctx, dto)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateJoinRequestToClub")
+	}
+
+	var r0 *domain.Invite
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dtos.SendJoinRequestToClub) (*domain.Invite, error)); ok {
+		return rf(ctx, dto)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *dtos.SendJoinRequestToClub) *domain.Invite); ok {
+		r0 = rf(ctx, dto)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Invite)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *dtos.SendJoinRequestToClub) error); ok {
+		r1 = rf(ctx, dto)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetClubJoinRequests provides a mock function with given fields: ctx, eventId
+func (_m *ClubInviteStorage) GetClubJoinRequests(ctx context.Context, eventId string) ([]domain.Invite, error) {
+	ret := _m.Called(ctx, eventId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetClubJoinRequests")
+	}
+
+	var r0 []domain.Invite
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]domain.Invite, error)); ok {
+		return rf(ctx, eventId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []domain.Invite); ok {
+		r0 = rf(ctx, eventId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Invite)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, eventId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetJoinRequestByClubId provides a mock function with given fields: ctx, eventId, clubId
+func (_m *ClubInviteStorage) GetJoinRequestByClubId(ctx context.Context, eventId string, clubId int64) (*domain.Invite, error) {
+	ret := _m.Called(ctx, eventId, clubId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetJoinRequestByClubId")
+	}
+
+	var r0 *domain.Invite
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) (*domain.Invite, error)); ok {
+		return rf(ctx, eventId, clubId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) *domain.Invite); ok {
+		r0 = rf(ctx, eventId, clubId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Invite)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64) error); ok {
+		r1 = rf(ctx, eventId, clubId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetJoinRequestsByClubInviteId provides a mock function with given fields: ctx, inviteId
+func (_m *ClubInviteStorage) GetJoinRequestsByClubInviteId(ctx context.Context, inviteId string) (*domain.Invite, error) {
+	ret := _m.Called(ctx, inviteId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetJoinRequestsByClubInviteId")
+	}
+
+	var r0 *domain.Invite
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*domain.Invite, error)); ok {
+		return rf(ctx, inviteId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *domain.Invite); ok {
+		r0 = rf(ctx, inviteId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Invite)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, inviteId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewClubInviteStorage creates a new instance of ClubInviteStorage. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewClubInviteStorage(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ClubInviteStorage {
+	mock := &ClubInviteStorage{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
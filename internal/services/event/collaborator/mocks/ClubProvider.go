@@ -0,0 +1,58 @@
+// Code generated by mockery v2.43.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uniclubs_club_service_v1_clubv1 "github.com/ARUMANDESU/uniclubs-protos/gen/go/club"
+)
+
+// ClubProvider is an autogenerated mock type for the ClubProvider type
+type ClubProvider struct {
+	mock.Mock
+}
+
+// HasPermission provides a mock function with given fields: ctx, userId, clubId, permission
+func (_m *ClubProvider) HasPermission(ctx context.Context, userId int64, clubId int64, permission uniclubs_club_service_v1_clubv1.Permission) (bool, error) {
+	ret := _m.Called(ctx, userId, clubId, permission)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HasPermission")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, uniclubs_club_service_v1_clubv1.Permission) (bool, error)); ok {
+		return rf(ctx, userId, clubId, permission)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, uniclubs_club_service_v1_clubv1.Permission) bool); ok {
+		r0 = rf(ctx, userId, clubId, permission)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64, uniclubs_club_service_v1_clubv1.Permission) error); ok {
+		r1 = rf(ctx, userId, clubId, permission)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewClubProvider creates a new instance of ClubProvider. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewClubProvider(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ClubProvider {
+	mock := &ClubProvider{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,90 @@
+// Code generated by mockery v2.43.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "github.com/arumandesu/uniclubs-posts-service/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// EventStorage is an autogenerated mock type for the EventStorage type
+type EventStorage struct {
+	mock.Mock
+}
+
+// GetEvent provides a mock function with given fields: ctx, eventId
+func (_m *EventStorage) GetEvent(ctx context.Context, eventId string) (*domain.Event, error) {
+	ret := _m.Called(ctx, eventId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEvent")
+	}
+
+	var r0 *domain.Event
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*domain.Event, error)); ok {
+		return rf(ctx, eventId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *domain.Event); ok {
+		r0 = rf(ctx, eventId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Event)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, eventId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateEvent provides a mock function with given fields: ctx, event
+func (_m *EventStorage) UpdateEvent(ctx context.Context, event *domain.Event) (*domain.Event, error) {
+	ret := _m.Called(ctx, event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateEvent")
+	}
+
+	var r0 *domain.Event
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Event) (*domain.Event, error)); ok {
+		return rf(ctx, event)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Event) *domain.Event); ok {
+		r0 = rf(ctx, event)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Event)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.Event) error); ok {
+		r1 = rf(ctx, event)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewEventStorage creates a new instance of EventStorage. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewEventStorage(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *EventStorage {
+	mock := &EventStorage{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
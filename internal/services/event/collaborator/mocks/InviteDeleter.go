@@ -0,0 +1,46 @@
+// Code generated by mockery v2.43.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// InviteDeleter is an autogenerated mock type for the InviteDeleter type
+type InviteDeleter struct {
+	mock.Mock
+}
+
+// DeleteInvite provides a mock function with given fields: ctx, inviteId
+func (_m *InviteDeleter) DeleteInvite(ctx context.Context, inviteId string) error {
+	ret := _m.Called(ctx, inviteId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteInvite")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, inviteId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewInviteDeleter creates a new instance of InviteDeleter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewInviteDeleter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *InviteDeleter {
+	mock := &InviteDeleter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
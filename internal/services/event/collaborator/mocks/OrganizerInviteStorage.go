@@ -0,0 +1,151 @@
+// Code generated by mockery v2.43.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "github.com/arumandesu/uniclubs-posts-service/internal/domain"
+	dtos "github.com/arumandesu/uniclubs-posts-service/internal/domain/dto"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// OrganizerInviteStorage is an autogenerated mock type for the OrganizerInviteStorage type
+type OrganizerInviteStorage struct {
+	mock.Mock
+}
+
+// CreateJoinRequestToUser provides a mock function with given fields: ctx, dto
+func (_m *OrganizerInviteStorage) CreateJoinRequestToUser(ctx context.Context, dto *dtos.SendJoinRequestToUser) (*domain.UserInvite, error) {
+	ret := _m.Called(ctx, dto)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateJoinRequestToUser")
+	}
+
+	var r0 *domain.UserInvite
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dtos.SendJoinRequestToUser) (*domain.UserInvite, error)); ok {
+		return rf(ctx, dto)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *dtos.SendJoinRequestToUser) *domain.UserInvite); ok {
+		r0 = rf(ctx, dto)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.UserInvite)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *dtos.SendJoinRequestToUser) error); ok {
+		r1 = rf(ctx, dto)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetJoinRequestByUserId provides a mock function with given fields: ctx, eventId, userId
+func (_m *OrganizerInviteStorage) GetJoinRequestByUserId(ctx context.Context, eventId string, userId int64) (*domain.UserInvite, error) {
+	ret := _m.Called(ctx, eventId, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetJoinRequestByUserId")
+	}
+
+	var r0 *domain.UserInvite
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) (*domain.UserInvite, error)); ok {
+		return rf(ctx, eventId, userId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) *domain.UserInvite); ok {
+		r0 = rf(ctx, eventId, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.UserInvite)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64) error); ok {
+		r1 = rf(ctx, eventId, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetJoinRequestsByUserInviteId provides a mock function with given fields: ctx, inviteId
+func (_m *OrganizerInviteStorage) GetJoinRequestsByUserInviteId(ctx context.Context, inviteId string) (*domain.UserInvite, error) {
+	ret := _m.Called(ctx, inviteId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetJoinRequestsByUserInviteId")
+	}
+
+	var r0 *domain.UserInvite
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*domain.UserInvite, error)); ok {
+		return rf(ctx, inviteId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *domain.UserInvite); ok {
+		r0 = rf(ctx, inviteId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.UserInvite)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, inviteId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetUserJoinRequests provides a mock function with given fields: ctx, eventId
+func (_m *OrganizerInviteStorage) GetUserJoinRequests(ctx context.Context, eventId string) ([]domain.UserInvite, error) {
+	ret := _m.Called(ctx, eventId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserJoinRequests")
+	}
+
+	var r0 []domain.UserInvite
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]domain.UserInvite, error)); ok {
+		return rf(ctx, eventId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []domain.UserInvite); ok {
+		r0 = rf(ctx, eventId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.UserInvite)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, eventId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewOrganizerInviteStorage creates a new instance of OrganizerInviteStorage. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewOrganizerInviteStorage(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *OrganizerInviteStorage {
+	mock := &OrganizerInviteStorage{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
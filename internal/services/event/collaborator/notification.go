@@ -0,0 +1,107 @@
+package eventcollab
+
+import (
+	"context"
+	"github.com/arumandesu/uniclubs-posts-service/internal/domain"
+	"github.com/arumandesu/uniclubs-posts-service/internal/rabbitmq"
+	"github.com/arumandesu/uniclubs-posts-service/pkg/logger"
+	"log/slog"
+	"time"
+)
+
+// InviteMessageVersion must be increased on every breaking change of the InviteMessage payload
+const InviteMessageVersion = 1
+
+// InviteMessage is published to the posts exchange on every invite lifecycle change
+type InviteMessage struct {
+	Version    int                `json:"version"`
+	InviteId   string             `json:"invite_id"`
+	Event      InviteMessageEvent `json:"event"`
+	Inviter    InviteMessageParty `json:"inviter"`
+	Target     InviteMessageParty `json:"target"`
+	OccurredAt time.Time          `json:"occurred_at"`
+}
+
+type InviteMessageEvent struct {
+	ID     string `json:"id"`
+	Title  string `json:"title,omitempty"`
+	ClubId int64  `json:"club_id,omitempty"`
+}
+
+type InviteMessageParty struct {
+	UserId int64  `json:"user_id,omitempty"`
+	ClubId int64  `json:"club_id,omitempty"`
+	Name   string `json:"name,omitempty"`
+}
+
+type collaboratorLeftMessage struct {
+	EventId     string      `json:"event_id"`
+	EventTitle  string      `json:"event_title"`
+	OwnerClubId int64       `json:"owner_club_id"`
+	Club        domain.Club `json:"club"`
+	UserId      int64       `json:"user_id"`
+}
+
+// newOrganizerInviteMessage builds the message of the organizer invite, event is optional and used to fill the event details
+func newOrganizerInviteMessage(invite domain.UserInvite, event *domain.Event) InviteMessage {
+	return InviteMessage{
+		Version:  InviteMessageVersion,
+		InviteId: invite.ID,
+		Event:    newInviteMessageEvent(invite.Event.ID, event),
+		Inviter: InviteMessageParty{
+			UserId: invite.ByWhoId,
+			ClubId: invite.ClubId,
+		},
+		Target: InviteMessageParty{
+			UserId: invite.User.ID,
+			ClubId: invite.ClubId,
+			Name:   invite.User.FirstName + " " + invite.User.LastName,
+		},
+		OccurredAt: time.Now(),
+	}
+}
+
+// newClubInviteMessage builds the message of the club invite, event is optional and used to fill the event details
+func newClubInviteMessage(invite domain.Invite, event *domain.Event) InviteMessage {
+	msg := InviteMessage{
+		Version:  InviteMessageVersion,
+		InviteId: invite.ID,
+		Event:    newInviteMessageEvent(invite.Event.ID, event),
+		Inviter: InviteMessageParty{
+			UserId: invite.ByWhoId,
+		},
+		Target: InviteMessageParty{
+			ClubId: invite.Club.ID,
+			Name:   invite.Club.Name,
+		},
+		OccurredAt: time.Now(),
+	}
+	if event != nil {
+		msg.Inviter.ClubId = event.ClubId
+	}
+
+	return msg
+}
+
+func newInviteMessageEvent(eventId string, event *domain.Event) InviteMessageEvent {
+	if event == nil {
+		return InviteMessageEvent{ID: eventId}
+	}
+
+	return InviteMessageEvent{
+		ID:     event.ID,
+		Title:  event.Title,
+		ClubId: event.ClubId,
+	}
+}
+
+// publish sends the message to the posts exchange, failures are only logged because the action itself is already done
+func (s Service) publish(ctx context.Context, log *slog.Logger, routingKey string, msg any) {
+	publishCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	err := s.publisher.Publish(publishCtx, rabbitmq.PostsExchangeName, routingKey, msg)
+	if err != nil {
+		log.Warn("failed to publish message", logger.Err(err), slog.String("routing_key", routingKey))
+	}
+}
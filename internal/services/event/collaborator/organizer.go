@@ -5,6 +5,7 @@ import (
 	"errors"
 	"github.com/arumandesu/uniclubs-posts-service/internal/domain"
 	"github.com/arumandesu/uniclubs-posts-service/internal/domain/dto"
+	"github.com/arumandesu/uniclubs-posts-service/internal/rabbitmq"
 	"github.com/arumandesu/uniclubs-posts-service/internal/services/event"
 	"github.com/arumandesu/uniclubs-posts-service/internal/storage"
 	"github.com/arumandesu/uniclubs-posts-service/pkg/logger"
@@ -30,8 +31,18 @@ func (s Service) SendJoinRequestToUser(ctx context.Context, dto *dtos.SendJoinRe
 		}
 	}
 
-	// Check if the user is event owner or organizer
-	if !event.IsOrganizer(dto.UserId) {
+	// Check if the user is allowed to invite organizers
+	if !event.HasPermission(dto.UserId, domain.EventPermissionInviteOrganizer) {
+		return nil, eventservice.ErrPermissionsDenied
+	}
+
+	if dto.Role == "" {
+		dto.Role = domain.DefaultOrganizerRole
+	}
+	if !dto.Role.IsValid() {
+		return nil, eventservice.ErrInvalidOrganizerRole
+	}
+	if !canAssignRole(event, dto.UserId, dto.Role) {
 		return nil, eventservice.ErrPermissionsDenied
 	}
 
@@ -66,7 +77,7 @@ func (s Service) SendJoinRequestToUser(ctx context.Context, dto *dtos.SendJoinRe
 
 	sendJoinRequestCtx, cancel := context.WithTimeout(ctx, 3*time.Second)
 	defer cancel()
-	_, err = s.userInviteStorage.CreateJoinRequestToUser(sendJoinRequestCtx, dto)
+	userInvite, err = s.userInviteStorage.CreateJoinRequestToUser(sendJoinRequestCtx, dto)
 	if err != nil {
 		switch {
 		case errors.Is(err, storage.ErrInvalidID):
@@ -77,7 +88,7 @@ func (s Service) SendJoinRequestToUser(ctx context.Context, dto *dtos.SendJoinRe
 		}
 	}
 
-	// todo: send push notification to the target user
+	s.publish(ctx, log, rabbitmq.OrganizerInviteCreatedRoutingKey, newOrganizerInviteMessage(*userInvite, event))
 
 	return event, nil
 }
@@ -141,6 +152,7 @@ func (s Service) AcceptUserJoinRequest(ctx context.Context, inviteId string, use
 			User:    invite.User,
 			ClubId:  invite.ClubId,
 			ByWhoId: invite.ByWhoId,
+			Role:    invite.Role,
 		}
 		event.AddOrganizer(newOrganizer)
 
@@ -172,6 +184,9 @@ func (s Service) AcceptUserJoinRequest(ctx context.Context, inviteId string, use
 			}
 		}
 	}
+
+	s.publish(ctx, log, rabbitmq.OrganizerInviteAcceptedRoutingKey, newOrganizerInviteMessage(*invite, event))
+
 	return *event, nil
 }
 
@@ -211,6 +226,8 @@ func (s Service) RejectUserJoinRequest(ctx context.Context, inviteId string, use
 		}
 	}
 
+	s.publish(ctx, log, rabbitmq.OrganizerInviteRejectedRoutingKey, newOrganizerInviteMessage(*invite, nil))
+
 	return domain.Event{}, nil
 }
 
@@ -240,7 +257,7 @@ func (s Service) KickOrganizer(ctx context.Context, eventId string, userId, targ
 		return nil, eventservice.ErrUserIsNotEventOrganizer
 	}
 
-	if !(target.IsByWho(userId) || event.IsOwner(userId)) {
+	if !(target.IsByWho(userId) || event.HasPermission(userId, domain.EventPermissionManageOrganizers)) {
 		return nil, eventservice.ErrPermissionsDenied
 	}
 
@@ -303,7 +320,7 @@ func (s Service) RevokeInviteOrganizer(ctx context.Context, inviteId string, use
 		}
 	}
 
-	if !(invite.IsByWho(userId) || event.IsOwner(userId)) {
+	if !(invite.IsByWho(userId) || event.HasPermission(userId, domain.EventPermissionManageOrganizers)) {
 		return eventservice.ErrPermissionsDenied
 	}
 
@@ -320,5 +337,94 @@ func (s Service) RevokeInviteOrganizer(ctx context.Context, inviteId string, use
 		}
 	}
 
+	s.publish(ctx, log, rabbitmq.OrganizerInviteRevokedRoutingKey, newOrganizerInviteMessage(*invite, event))
+
 	return nil
 }
+
+func (s Service) ChangeOrganizerRole(ctx context.Context, dto *dtos.ChangeOrganizerRole) (*domain.Event, error) {
+	const op = "services.event.collaborator.changeOrganizerRole"
+	log := s.log.With(slog.String("op", op))
+
+	event, err := s.eventStorage.GetEvent(ctx, dto.EventId)
+	if err != nil {
+		switch {
+		case errors.Is(err, storage.ErrEventNotFound):
+			return nil, eventservice.ErrEventNotFound
+		case errors.Is(err, storage.ErrInvalidID):
+			return nil, eventservice.ErrInvalidID
+		default:
+			log.Error("failed to get event", logger.Err(err))
+			return nil, err
+		}
+	}
+
+	if !event.HasPermission(dto.UserId, domain.EventPermissionManageOrganizers) {
+		return nil, eventservice.ErrPermissionsDenied
+	}
+
+	if !canAssignRole(event, dto.UserId, dto.Role) {
+		return nil, eventservice.ErrPermissionsDenied
+	}
+
+	target := event.GetOrganizerById(dto.OrganizerId)
+	if target == nil {
+		return nil, eventservice.ErrUserIsNotEventOrganizer
+	}
+
+	// co-owners can be demoted only by the owner
+	if target.Role == domain.OrganizerRoleCoOwner && !event.IsOwner(dto.UserId) {
+		return nil, eventservice.ErrPermissionsDenied
+	}
+
+	err = event.ChangeOrganizerRole(dto.OrganizerId, dto.Role)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrInvalidOrganizerRole):
+			return nil, eventservice.ErrInvalidOrganizerRole
+		case errors.Is(err, domain.ErrUserIsEventOwner):
+			return nil, eventservice.ErrUserIsEventOwner
+		case errors.Is(err, domain.ErrOrganizerNotFound):
+			return nil, eventservice.ErrUserIsNotEventOrganizer
+		default:
+			log.Error("failed to change organizer role", logger.Err(err))
+			return nil, err
+		}
+	}
+
+	updateCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	event, err = s.eventStorage.UpdateEvent(updateCtx, event)
+	if err != nil {
+		switch {
+		case errors.Is(err, storage.ErrOptimisticLockingFailed):
+			return nil, eventservice.ErrEventUpdateConflict
+		default:
+			log.Error("failed to update event", logger.Err(err))
+			return nil, err
+		}
+	}
+
+	return event, nil
+}
+
+/*
+canAssignRole checks if the user is allowed to give the role to other organizers.
+
+	The user must hold every permission of the role, so nobody grants the powers they lack,
+	e.g. an editor can't invite a moderator who can kick and ban the participants.
+	Only the owner can assign co-owners.
+*/
+func canAssignRole(event *domain.Event, userId int64, role domain.OrganizerRole) bool {
+	if role == domain.OrganizerRoleCoOwner {
+		return event.IsOwner(userId)
+	}
+
+	for _, permission := range role.Permissions() {
+		if !event.HasPermission(userId, permission) {
+			return false
+		}
+	}
+
+	return true
+}
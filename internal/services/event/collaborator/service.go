@@ -2,6 +2,7 @@ package eventcollab
 
 import (
 	"context"
+	clubv1 "github.com/ARUMANDESU/uniclubs-protos/gen/go/club"
 	"github.com/arumandesu/uniclubs-posts-service/internal/domain"
 	"github.com/arumandesu/uniclubs-posts-service/internal/domain/dto"
 	"log/slog"
@@ -13,13 +14,17 @@ type Service struct {
 	userInviteStorage OrganizerInviteStorage
 	clubInviteStorage ClubInviteStorage
 	inviteDeleter     InviteDeleter
+	clubProvider      ClubProvider
+	publisher         Publisher
 }
 
+//go:generate mockery --name EventStorage
 type EventStorage interface {
 	GetEvent(ctx context.Context, eventId string) (*domain.Event, error)
 	UpdateEvent(ctx context.Context, event *domain.Event) (*domain.Event, error)
 }
 
+//go:generate mockery --name OrganizerInviteStorage
 type OrganizerInviteStorage interface {
 	CreateJoinRequestToUser(ctx context.Context, dto *dtos.SendJoinRequestToUser) (*domain.UserInvite, error)
 	GetUserJoinRequests(ctx context.Context, eventId string) ([]domain.UserInvite, error)
@@ -27,6 +32,7 @@ type OrganizerInviteStorage interface {
 	GetJoinRequestByUserId(ctx context.Context, eventId string, userId int64) (*domain.UserInvite, error)
 }
 
+//go:generate mockery --name ClubInviteStorage
 type ClubInviteStorage interface {
 	CreateJoinRequestToClub(ctx context.Context, dto *dtos.SendJoinRequestToClub) (*domain.Invite, error)
 	GetClubJoinRequests(ctx context.Context, eventId string) ([]domain.Invite, error)
@@ -34,16 +40,29 @@ type ClubInviteStorage interface {
 	GetJoinRequestByClubId(ctx context.Context, eventId string, clubId int64) (*domain.Invite, error)
 }
 
+//go:generate mockery --name InviteDeleter
 type InviteDeleter interface {
 	DeleteInvite(ctx context.Context, inviteId string) error
 }
 
+//go:generate mockery --name ClubProvider
+type ClubProvider interface {
+	HasPermission(ctx context.Context, userId, clubId int64, permission clubv1.Permission) (bool, error)
+}
+
+//go:generate mockery --name Publisher
+type Publisher interface {
+	Publish(ctx context.Context, exchangeName string, routingKey string, msg any) error
+}
+
 func New(
 	log *slog.Logger,
 	eventProvider EventStorage,
 	organizerInviteStorage OrganizerInviteStorage,
 	clubInviteStorage ClubInviteStorage,
 	inviteDeleter InviteDeleter,
+	clubProvider ClubProvider,
+	publisher Publisher,
 ) Service {
 	return Service{
 		log:               log,
@@ -51,5 +70,7 @@ func New(
 		userInviteStorage: organizerInviteStorage,
 		clubInviteStorage: clubInviteStorage,
 		inviteDeleter:     inviteDeleter,
+		clubProvider:      clubProvider,
+		publisher:         publisher,
 	}
 }
@@ -0,0 +1,199 @@
+package eventcollab
+
+import (
+	"context"
+	"github.com/arumandesu/uniclubs-posts-service/internal/domain"
+	"github.com/arumandesu/uniclubs-posts-service/internal/domain/dto"
+	"github.com/arumandesu/uniclubs-posts-service/internal/rabbitmq"
+	eventservice "github.com/arumandesu/uniclubs-posts-service/internal/services/event"
+	"github.com/arumandesu/uniclubs-posts-service/internal/services/event/collaborator/mocks"
+	"github.com/arumandesu/uniclubs-posts-service/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"io"
+	"log/slog"
+	"testing"
+)
+
+type suite struct {
+	Service          Service
+	eventStorage     *mocks.EventStorage
+	organizerInvites *mocks.OrganizerInviteStorage
+	clubInvites      *mocks.ClubInviteStorage
+	inviteDeleter    *mocks.InviteDeleter
+	clubProvider     *mocks.ClubProvider
+	publisher        *mocks.Publisher
+}
+
+func setupSuite(t *testing.T) *suite {
+	t.Helper()
+
+	s := &suite{
+		eventStorage:     mocks.NewEventStorage(t),
+		organizerInvites: mocks.NewOrganizerInviteStorage(t),
+		clubInvites:      mocks.NewClubInviteStorage(t),
+		inviteDeleter:    mocks.NewInviteDeleter(t),
+		clubProvider:     mocks.NewClubProvider(t),
+		publisher:        mocks.NewPublisher(t),
+	}
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+	s.Service = New(log, s.eventStorage, s.organizerInvites, s.clubInvites, s.inviteDeleter, s.clubProvider, s.publisher)
+
+	return s
+}
+
+func inviteMessage(t *testing.T, args mock.Arguments) InviteMessage {
+	t.Helper()
+
+	assert.Equal(t, rabbitmq.PostsExchangeName, args.String(1))
+	msg, ok := args.Get(3).(InviteMessage)
+	require.True(t, ok, "published message must be an InviteMessage")
+	assert.Equal(t, InviteMessageVersion, msg.Version)
+	return msg
+}
+
+func TestService_SendJoinRequestToUser_PublishesInviteCreated(t *testing.T) {
+	suite := setupSuite(t)
+
+	event := &domain.Event{
+		ID:      "event-id",
+		ClubId:  10,
+		OwnerId: 1,
+		Title:   "Hackathon",
+		Organizers: []domain.Organizer{
+			{User: domain.User{ID: 1}, ClubId: 10, Role: domain.OrganizerRoleCoOwner},
+		},
+	}
+	dto := &dtos.SendJoinRequestToUser{
+		EventId:      event.ID,
+		UserId:       1,
+		Target:       domain.User{ID: 2, FirstName: "John", LastName: "Doe"},
+		TargetClubId: 10,
+	}
+	invite := &domain.UserInvite{
+		ID:      "invite-id",
+		Event:   domain.Event{ID: event.ID},
+		ClubId:  10,
+		ByWhoId: 1,
+		User:    dto.Target,
+		Role:    domain.DefaultOrganizerRole,
+	}
+
+	suite.eventStorage.On("GetEvent", mock.Anything, event.ID).Return(event, nil)
+	suite.organizerInvites.On("GetJoinRequestByUserId", mock.Anything, event.ID, dto.Target.ID).Return((*domain.UserInvite)(nil), storage.ErrInviteNotFound)
+	suite.organizerInvites.On("CreateJoinRequestToUser", mock.Anything, dto).Return(invite, nil)
+	suite.publisher.On("Publish", mock.Anything, rabbitmq.PostsExchangeName, rabbitmq.OrganizerInviteCreatedRoutingKey, mock.Anything).
+		Run(func(args mock.Arguments) {
+			msg := inviteMessage(t, args)
+			assert.Equal(t, "invite-id", msg.InviteId)
+			assert.Equal(t, InviteMessageEvent{ID: event.ID, Title: event.Title, ClubId: event.ClubId}, msg.Event)
+			assert.Equal(t, int64(1), msg.Inviter.UserId)
+			assert.Equal(t, int64(2), msg.Target.UserId)
+			assert.Equal(t, "John Doe", msg.Target.Name)
+		}).
+		Return(nil)
+
+	_, err := suite.Service.SendJoinRequestToUser(context.Background(), dto)
+	assert.NoError(t, err)
+}
+
+func TestService_SendJoinRequestToUser_RoleBeyondInviterPermissions(t *testing.T) {
+	event := &domain.Event{
+		ID:      "event-id",
+		ClubId:  10,
+		OwnerId: 1,
+		Organizers: []domain.Organizer{
+			{User: domain.User{ID: 2}, ClubId: 10, Role: domain.OrganizerRoleEditor},
+		},
+	}
+
+	tests := []struct {
+		name string
+		role domain.OrganizerRole
+	}{
+		// the empty role is the default moderator one, it can kick and ban the participants unlike the editor
+		{name: "default role", role: ""},
+		{name: "moderator", role: domain.OrganizerRoleModerator},
+		{name: "co-owner", role: domain.OrganizerRoleCoOwner},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			suite := setupSuite(t)
+			suite.eventStorage.On("GetEvent", mock.Anything, event.ID).Return(event, nil)
+
+			_, err := suite.Service.SendJoinRequestToUser(context.Background(), &dtos.SendJoinRequestToUser{
+				EventId:      event.ID,
+				UserId:       2,
+				Target:       domain.User{ID: 3},
+				TargetClubId: 10,
+				Role:         tt.role,
+			})
+			assert.ErrorIs(t, err, eventservice.ErrPermissionsDenied)
+		})
+	}
+}
+
+func TestService_RejectUserJoinRequest_PublishesInviteRejected(t *testing.T) {
+	suite := setupSuite(t)
+
+	invite := &domain.UserInvite{
+		ID:      "invite-id",
+		Event:   domain.Event{ID: "event-id"},
+		ClubId:  10,
+		ByWhoId: 1,
+		User:    domain.User{ID: 2},
+	}
+
+	suite.organizerInvites.On("GetJoinRequestsByUserInviteId", mock.Anything, invite.ID).Return(invite, nil)
+	suite.inviteDeleter.On("DeleteInvite", mock.Anything, invite.ID).Return(nil)
+	suite.publisher.On("Publish", mock.Anything, rabbitmq.PostsExchangeName, rabbitmq.OrganizerInviteRejectedRoutingKey, mock.Anything).
+		Run(func(args mock.Arguments) {
+			msg := inviteMessage(t, args)
+			assert.Equal(t, InviteMessageEvent{ID: "event-id"}, msg.Event)
+			assert.Equal(t, int64(2), msg.Target.UserId)
+		}).
+		Return(nil)
+
+	_, err := suite.Service.RejectUserJoinRequest(context.Background(), invite.ID, 2)
+	assert.NoError(t, err)
+}
+
+func TestService_RejectUserJoinRequest_NotInvited(t *testing.T) {
+	suite := setupSuite(t)
+
+	invite := &domain.UserInvite{ID: "invite-id", User: domain.User{ID: 2}}
+
+	suite.organizerInvites.On("GetJoinRequestsByUserInviteId", mock.Anything, invite.ID).Return(invite, nil)
+
+	_, err := suite.Service.RejectUserJoinRequest(context.Background(), invite.ID, 3)
+	assert.Error(t, err)
+	suite.publisher.AssertNotCalled(t, "Publish", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestService_RevokeInviteClub_PublishesInviteRevoked(t *testing.T) {
+	suite := setupSuite(t)
+
+	event := &domain.Event{ID: "event-id", ClubId: 10, OwnerId: 1, Title: "Hackathon"}
+	invite := &domain.Invite{
+		ID:      "invite-id",
+		Event:   domain.Event{ID: event.ID},
+		Club:    domain.Club{ID: 20, Name: "Chess club"},
+		ByWhoId: 1,
+	}
+
+	suite.clubInvites.On("GetJoinRequestsByClubInviteId", mock.Anything, invite.ID).Return(invite, nil)
+	suite.eventStorage.On("GetEvent", mock.Anything, event.ID).Return(event, nil)
+	suite.inviteDeleter.On("DeleteInvite", mock.Anything, invite.ID).Return(nil)
+	suite.publisher.On("Publish", mock.Anything, rabbitmq.PostsExchangeName, rabbitmq.ClubInviteRevokedRoutingKey, mock.Anything).
+		Run(func(args mock.Arguments) {
+			msg := inviteMessage(t, args)
+			assert.Equal(t, InviteMessageParty{UserId: 1, ClubId: 10}, msg.Inviter)
+			assert.Equal(t, InviteMessageParty{ClubId: 20, Name: "Chess club"}, msg.Target)
+		}).
+		Return(nil)
+
+	err := suite.Service.RevokeInviteClub(context.Background(), invite.ID, 1)
+	assert.NoError(t, err)
+}
@@ -3,22 +3,26 @@ package eventinfo
 import (
 	"context"
 	"errors"
+	"fmt"
 	"github.com/arumandesu/uniclubs-posts-service/internal/domain"
 	"github.com/arumandesu/uniclubs-posts-service/internal/domain/dto"
 	"github.com/arumandesu/uniclubs-posts-service/internal/services/event"
 	"github.com/arumandesu/uniclubs-posts-service/internal/storage"
 	"github.com/arumandesu/uniclubs-posts-service/pkg/logger"
 	"log/slog"
+	"time"
 )
 
 type Service struct {
-	log *slog.Logger
+	log  *slog.Logger
+	tags TagResolver
 	Storage
 }
 
 type EventProvider interface {
 	GetEvent(ctx context.Context, eventId string) (*domain.Event, error)
 	ListEvents(ctx context.Context, filters domain.EventsFilter) ([]domain.Event, *domain.PaginationMetadata, error)
+	GetEventFacets(ctx context.Context, filters domain.EventsFilter) (*domain.EventFacets, error)
 }
 
 type ParticipantProvider interface {
@@ -33,6 +37,21 @@ type BanProvider interface {
 
 type ClubProvider interface {
 	IsBanned(ctx context.Context, userId int64, clubId int64) (bool, error)
+	GetUserClubs(ctx context.Context, userId int64) ([]domain.Club, error)
+}
+
+type RecommendationProvider interface {
+	GetAttendedEvents(ctx context.Context, userId int64, limit int64) ([]domain.Event, error)
+	ListRecommendationCandidates(ctx context.Context, userId int64, now time.Time, limit int64) ([]domain.Event, error)
+}
+
+type ReactionProvider interface {
+	GetUserReactions(ctx context.Context, targetType domain.TargetType, targetId string, userId int64) ([]domain.ReactionType, error)
+}
+
+// TagResolver maps the filter tags to the canonical ones, so the merged tags match the retagged events
+type TagResolver interface {
+	Resolve(ctx context.Context, tags []string) ([]string, error)
 }
 
 type InviteProvider interface {
@@ -40,9 +59,10 @@ type InviteProvider interface {
 	GetClubInvites(ctx context.Context, dto *dtos.GetInvites) ([]domain.Invite, error)
 }
 
-func New(log *slog.Logger, storage Storage) Service {
+func New(log *slog.Logger, storage Storage, tags TagResolver) Service {
 	return Service{
 		log:     log,
+		tags:    tags,
 		Storage: storage,
 	}
 }
@@ -69,17 +89,24 @@ func (s Service) GetEvent(ctx context.Context, eventId string, userId int64) (*d
 		userStatus = domain.UserStatusOwner
 	}
 
+	var userReactions []domain.ReactionType
 	if userId != 0 {
 		participantStatus, err = s.getParticipantStatus(ctx, event, userId)
 		if err != nil {
 			return nil, s.handleError("failed to get ban status", log, err)
 		}
+
+		userReactions, err = s.reactionProvider.GetUserReactions(ctx, domain.TargetEvent, event.ID, userId)
+		if err != nil {
+			return nil, s.handleError("failed to get user reactions", log, err)
+		}
 	}
 
 	return &dtos.GetEvent{
 		Event:             *event,
 		UserStatus:        userStatus,
 		ParticipantStatus: participantStatus,
+		UserReactions:     userReactions,
 	}, nil
 }
 
@@ -87,11 +114,23 @@ func (s Service) ListEvents(ctx context.Context, filters domain.EventsFilter) ([
 	const op = "services.event.management.listEvents"
 	log := s.log.With(slog.String("op", op))
 
+	if err := filters.ValidateDates(); err != nil {
+		return nil, nil, fmt.Errorf("%w: %w", eventservice.ErrEventInvalidFields, err)
+	}
+
+	var err error
+	filters.Tags, err = s.tags.Resolve(ctx, filters.Tags)
+	if err != nil {
+		return nil, nil, s.handleError("failed to resolve tags", log, err)
+	}
+
 	events, pagination, err := s.eventProvider.ListEvents(ctx, filters)
 	if err != nil {
 		switch {
 		case errors.Is(err, storage.ErrEventNotFound):
 			return nil, nil, eventservice.ErrEventNotFound
+		case errors.Is(err, storage.ErrInvalidCursor):
+			return nil, nil, eventservice.ErrInvalidCursor
 		default:
 			log.Error("failed to list events", logger.Err(err))
 			return nil, nil, err
@@ -101,6 +140,66 @@ func (s Service) ListEvents(ctx context.Context, filters domain.EventsFilter) ([
 	return events, pagination, nil
 }
 
+// GetEventFacets returns the counts of the events matching the same filter as ListEvents
+func (s Service) GetEventFacets(ctx context.Context, filters domain.EventsFilter) (*domain.EventFacets, error) {
+	const op = "services.event.info.getEventFacets"
+	log := s.log.With(slog.String("op", op))
+
+	if err := filters.ValidateDates(); err != nil {
+		return nil, fmt.Errorf("%w: %w", eventservice.ErrEventInvalidFields, err)
+	}
+
+	var err error
+	filters.Tags, err = s.tags.Resolve(ctx, filters.Tags)
+	if err != nil {
+		return nil, s.handleError("failed to resolve tags", log, err)
+	}
+
+	facets, err := s.eventProvider.GetEventFacets(ctx, filters)
+	if err != nil {
+		return nil, s.handleError("failed to get event facets", log, err)
+	}
+
+	return facets, nil
+}
+
+/*
+GetRecommendedEvents ranks the upcoming published events for the user by the tags and clubs of the events they attended,
+their club memberships, the popularity and how soon the events start, every event is returned with the explanations.
+
+	The memberships are optional: when the club service fails the feed is ranked without them.
+*/
+func (s Service) GetRecommendedEvents(ctx context.Context, dto *dtos.GetRecommendedEvents) ([]domain.RecommendedEvent, error) {
+	const op = "services.event.info.getRecommendedEvents"
+	log := s.log.With(slog.String("op", op))
+
+	if dto.UserId == 0 {
+		return nil, fmt.Errorf("%w: missing user id", eventservice.ErrEventInvalidFields)
+	}
+	limit := dto.Limit
+	if limit <= 0 || limit > domain.MaxRecommendedEvents {
+		limit = domain.MaxRecommendedEvents
+	}
+
+	attended, err := s.recommendationProvider.GetAttendedEvents(ctx, dto.UserId, domain.RecommendationHistorySize)
+	if err != nil {
+		return nil, s.handleError("failed to get attended events", log, err)
+	}
+
+	clubs, err := s.clubProvider.GetUserClubs(ctx, dto.UserId)
+	if err != nil {
+		log.Warn("failed to get user clubs, ranking without memberships", logger.Err(err))
+	}
+
+	now := time.Now()
+	candidates, err := s.recommendationProvider.ListRecommendationCandidates(ctx, dto.UserId, now, domain.RecommendationCandidatesSize)
+	if err != nil {
+		return nil, s.handleError("failed to list recommendation candidates", log, err)
+	}
+
+	return domain.RankRecommendations(candidates, domain.NewUserInterests(attended, clubs), now, limit), nil
+}
+
 func (s Service) GetUserInvites(ctx context.Context, dto *dtos.GetInvites) ([]domain.UserInvite, error) {
 	const op = "services.event.management.getUserInvites"
 	log := s.log.With(slog.String("op", op))
@@ -171,6 +270,8 @@ func (s Service) handleError(msg string, log *slog.Logger, err error) error {
 		return eventservice.ErrBanRecordNotFound
 	case errors.Is(err, storage.ErrInviteNotFound):
 		return eventservice.ErrInviteNotFound
+	case errors.Is(err, storage.ErrInvalidCursor):
+		return eventservice.ErrInvalidCursor
 	default:
 		log.Error(msg, logger.Err(err))
 		return err
@@ -210,11 +311,13 @@ func (s Service) getParticipantStatus(ctx context.Context, event *domain.Event,
 }
 
 type Storage struct {
-	eventProvider       EventProvider
-	participantProvider ParticipantProvider
-	banProvider         BanProvider
-	clubProvider        ClubProvider
-	inviteProvider      InviteProvider
+	eventProvider          EventProvider
+	participantProvider    ParticipantProvider
+	banProvider            BanProvider
+	clubProvider           ClubProvider
+	inviteProvider         InviteProvider
+	reactionProvider       ReactionProvider
+	recommendationProvider RecommendationProvider
 }
 
 func NewStorage(
@@ -223,12 +326,16 @@ func NewStorage(
 	banProvider BanProvider,
 	clubProvider ClubProvider,
 	inviteProvider InviteProvider,
+	reactionProvider ReactionProvider,
+	recommendationProvider RecommendationProvider,
 ) Storage {
 	return Storage{
-		eventProvider:       eventProvider,
-		participantProvider: participantProvider,
-		banProvider:         banProvider,
-		clubProvider:        clubProvider,
-		inviteProvider:      inviteProvider,
+		eventProvider:          eventProvider,
+		participantProvider:    participantProvider,
+		banProvider:            banProvider,
+		clubProvider:           clubProvider,
+		inviteProvider:         inviteProvider,
+		reactionProvider:       reactionProvider,
+		recommendationProvider: recommendationProvider,
 	}
 }
@@ -0,0 +1,88 @@
+// Code generated by mockery v2.43.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "github.com/arumandesu/uniclubs-posts-service/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// AnnouncementStorage is an autogenerated mock type for the AnnouncementStorage type
+type AnnouncementStorage struct {
+	mock.Mock
+}
+
+// CreatePost provides a mock function with given fields: ctx, post
+func (_m *AnnouncementStorage) CreatePost(ctx context.Context, post *domain.Post) (*domain.Post, error) {
+	ret := _m.Called(ctx, post)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreatePost")
+	}
+
+	var r0 *domain.Post
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Post) (*domain.Post, error)); ok {
+		return rf(ctx, post)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Post) *domain.Post); ok {
+		r0 = rf(ctx, post)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Post)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.Post) error); ok {
+		r1 = rf(ctx, post)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// HasEventPost provides a mock function with given fields: ctx, eventId, clubId
+func (_m *AnnouncementStorage) HasEventPost(ctx context.Context, eventId string, clubId int64) (bool, error) {
+	ret := _m.Called(ctx, eventId, clubId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HasEventPost")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) (bool, error)); ok {
+		return rf(ctx, eventId, clubId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) bool); ok {
+		r0 = rf(ctx, eventId, clubId)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64) error); ok {
+		r1 = rf(ctx, eventId, clubId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewAnnouncementStorage creates a new instance of AnnouncementStorage. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAnnouncementStorage(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AnnouncementStorage {
+	mock := &AnnouncementStorage{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
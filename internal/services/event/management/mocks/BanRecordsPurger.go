@@ -0,0 +1,46 @@
+// Code generated by mockery v2.43.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// BanRecordsPurger is an autogenerated mock type for the BanRecordsPurger type
+type BanRecordsPurger struct {
+	mock.Mock
+}
+
+// PurgeBanRecords provides a mock function with given fields: ctx, eventId
+func (_m *BanRecordsPurger) PurgeBanRecords(ctx context.Context, eventId string) error {
+	ret := _m.Called(ctx, eventId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PurgeBanRecords")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, eventId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewBanRecordsPurger creates a new instance of BanRecordsPurger. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewBanRecordsPurger(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *BanRecordsPurger {
+	mock := &BanRecordsPurger{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
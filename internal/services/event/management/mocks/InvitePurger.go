@@ -0,0 +1,46 @@
+// Code generated by mockery v2.43.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// InvitePurger is an autogenerated mock type for the InvitePurger type
+type InvitePurger struct {
+	mock.Mock
+}
+
+// PurgeInvites provides a mock function with given fields: ctx, eventId
+func (_m *InvitePurger) PurgeInvites(ctx context.Context, eventId string) error {
+	ret := _m.Called(ctx, eventId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PurgeInvites")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, eventId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewInvitePurger creates a new instance of InvitePurger. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewInvitePurger(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *InvitePurger {
+	mock := &InvitePurger{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,65 @@
+// Code generated by mockery v2.43.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "github.com/arumandesu/uniclubs-posts-service/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MentionResolver is an autogenerated mock type for the MentionResolver type
+type MentionResolver struct {
+	mock.Mock
+}
+
+// NotifyMentioned provides a mock function with given fields: ctx, source, previous, current
+func (_m *MentionResolver) NotifyMentioned(ctx context.Context, source domain.MentionSource, previous []domain.Mention, current []domain.Mention) {
+	_m.Called(ctx, source, previous, current)
+}
+
+// Resolve provides a mock function with given fields: ctx, text
+func (_m *MentionResolver) Resolve(ctx context.Context, text string) ([]domain.Mention, error) {
+	ret := _m.Called(ctx, text)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Resolve")
+	}
+
+	var r0 []domain.Mention
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]domain.Mention, error)); ok {
+		return rf(ctx, text)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []domain.Mention); ok {
+		r0 = rf(ctx, text)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Mention)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, text)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewMentionResolver creates a new instance of MentionResolver. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMentionResolver(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MentionResolver {
+	mock := &MentionResolver{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
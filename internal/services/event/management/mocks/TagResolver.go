@@ -0,0 +1,63 @@
+// Code generated by mockery v2.43.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// TagResolver is an autogenerated mock type for the TagResolver type
+type TagResolver struct {
+	mock.Mock
+}
+
+// Resolve provides a mock function with given fields: ctx, tags
+func (_m *TagResolver) Resolve(ctx context.Context, tags []string) ([]string, error) {
+	ret := _m.Called(ctx, tags)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Resolve")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) ([]string, error)); ok {
+		return rf(ctx, tags)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) []string); ok {
+		r0 = rf(ctx, tags)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, tags)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TrackUsage provides a mock function with given fields: ctx, previous, current
+func (_m *TagResolver) TrackUsage(ctx context.Context, previous []string, current []string) {
+	_m.Called(ctx, previous, current)
+}
+
+// NewTagResolver creates a new instance of TagResolver. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTagResolver(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TagResolver {
+	mock := &TagResolver{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/arumandesu/uniclubs-posts-service/internal/services/event"
 	"github.com/arumandesu/uniclubs-posts-service/internal/storage"
 	"github.com/arumandesu/uniclubs-posts-service/pkg/validate"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 	"log/slog"
 	"sync"
 	"time"
@@ -17,8 +18,10 @@ import (
 )
 
 type Service struct {
-	log *slog.Logger
-	wg  *sync.WaitGroup
+	log      *slog.Logger
+	wg       *sync.WaitGroup
+	mentions MentionResolver
+	tags     TagResolver
 	Storages
 }
 
@@ -27,6 +30,8 @@ type Storages struct {
 	ParticipantsPurger ParticipantsPurger
 	BanRecordsPurger   BanRecordsPurger
 	InvitePurger       InvitePurger
+	// AnnouncementStorage creates the announcement posts of the events with AutoAnnounce enabled
+	AnnouncementStorage AnnouncementStorage
 }
 
 //go:generate mockery --name EventStorage
@@ -37,20 +42,43 @@ type EventStorage interface {
 	DeleteEventById(ctx context.Context, eventId string) error
 }
 
+//go:generate mockery --name ParticipantsPurger
 type ParticipantsPurger interface {
 	PurgeParticipants(ctx context.Context, eventId string) error
 }
 
+//go:generate mockery --name BanRecordsPurger
 type BanRecordsPurger interface {
 	PurgeBanRecords(ctx context.Context, eventId string) error
 }
 
+//go:generate mockery --name InvitePurger
 type InvitePurger interface {
 	PurgeInvites(ctx context.Context, eventId string) error
 }
 
-func New(log *slog.Logger, wg *sync.WaitGroup, storages Storages) Service {
-	return Service{log: log, wg: wg, Storages: storages}
+//go:generate mockery --name AnnouncementStorage
+type AnnouncementStorage interface {
+	CreatePost(ctx context.Context, post *domain.Post) (*domain.Post, error)
+	HasEventPost(ctx context.Context, eventId string, clubId int64) (bool, error)
+}
+
+//go:generate mockery --name MentionResolver
+type MentionResolver interface {
+	Resolve(ctx context.Context, text string) ([]domain.Mention, error)
+	NotifyMentioned(ctx context.Context, source domain.MentionSource, previous, current []domain.Mention)
+}
+
+// TagResolver replaces the tags with their canonical registry tags and keeps the tag usage counters
+//
+//go:generate mockery --name TagResolver
+type TagResolver interface {
+	Resolve(ctx context.Context, tags []string) ([]string, error)
+	TrackUsage(ctx context.Context, previous, current []string)
+}
+
+func New(log *slog.Logger, wg *sync.WaitGroup, storages Storages, mentions MentionResolver, tags TagResolver) Service {
+	return Service{log: log, wg: wg, mentions: mentions, tags: tags, Storages: storages}
 }
 
 func (s Service) CreateEvent(ctx context.Context, club domain.Club, user domain.User) (*domain.Event, error) {
@@ -72,7 +100,7 @@ func (s Service) UpdateEvent(ctx context.Context, dto *dtos.UpdateEvent) (*domai
 	const op = "services.event.management.updateEvent"
 	log := s.log.With(slog.String("op", op))
 
-	event, err := s.FetchEventAndCheckOwner(ctx, dto.EventId, dto.UserId)
+	event, err := s.FetchEventAndCheckPermission(ctx, dto.EventId, dto.UserId, domain.EventPermissionUpdateEvent)
 	if err != nil {
 		return nil, err
 	}
@@ -82,6 +110,9 @@ func (s Service) UpdateEvent(ctx context.Context, dto *dtos.UpdateEvent) (*domai
 	}
 
 	hasUnchangeableFields := dto.HasUnchangeableFields()
+	previousMentions := event.Mentions
+	previousTags := event.Tags
+	wasPublished := event.IsPublished()
 
 	switch event.Status {
 	case domain.EventStatusFinished, domain.EventStatusCanceled, domain.EventStatusArchived:
@@ -100,6 +131,13 @@ func (s Service) UpdateEvent(ctx context.Context, dto *dtos.UpdateEvent) (*domai
 		return nil, fmt.Errorf("%w: %s", eventservice.ErrUnknownStatus, event.Status)
 	}
 
+	if dto.Paths["tags"] {
+		dto.Tags, err = s.tags.Resolve(ctx, dto.Tags)
+		if err != nil {
+			return nil, s.handleError("failed to resolve tags", log, err)
+		}
+	}
+
 	updateFunctions := map[string]func(){
 		"title":                     func() { event.Title = dto.Title },
 		"description":               func() { event.Description = dto.Description },
@@ -114,6 +152,7 @@ func (s Service) UpdateEvent(ctx context.Context, dto *dtos.UpdateEvent) (*domai
 		"attached_images":           func() { event.AttachedImages = dto.AttachedImages },
 		"attached_files":            func() { event.AttachedFiles = dto.AttachedFiles },
 		"is_hidden_for_non_members": func() { event.IsHiddenForNonMembers = dto.IsHiddenForNonMembers },
+		"auto_announce":             func() { event.AutoAnnounce = dto.AutoAnnounce },
 	}
 
 	for path, exists := range dto.Paths {
@@ -125,6 +164,12 @@ func (s Service) UpdateEvent(ctx context.Context, dto *dtos.UpdateEvent) (*domai
 		}
 	}
 
+	if dto.Paths["description"] {
+		if err = s.prepareDescription(ctx, event); err != nil {
+			return nil, s.handleError("failed to prepare description", log, err)
+		}
+	}
+
 	updateCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
 
@@ -133,9 +178,50 @@ func (s Service) UpdateEvent(ctx context.Context, dto *dtos.UpdateEvent) (*domai
 		return nil, s.handleError("failed to update event", log, err)
 	}
 
+	s.notifyMentioned(ctx, updatedEvent, dto.UserId, wasPublished, previousMentions)
+	s.tags.TrackUsage(ctx, previousTags, updatedEvent.Tags)
+
 	return updatedEvent, nil
 }
 
+/*
+notifyMentioned notifies the users newly mentioned in the published event, nobody is notified before the event is published.
+
+	The users mentioned before the publication are notified once the event is published,
+	previous are the mentions of the event before the change and wasPublished is its state before the change.
+*/
+func (s Service) notifyMentioned(ctx context.Context, event *domain.Event, authorId int64, wasPublished bool, previous []domain.Mention) {
+	if !event.IsPublished() {
+		return
+	}
+	if !wasPublished {
+		previous = nil
+	}
+
+	s.mentions.NotifyMentioned(ctx, domain.MentionSource{
+		TargetType: domain.TargetEvent,
+		TargetId:   event.ID,
+		Title:      event.Title,
+		ClubId:     event.ClubId,
+		AuthorId:   authorId,
+	}, previous, event.Mentions)
+}
+
+// prepareDescription renders the event markdown description and resolves the mentions in it
+func (s Service) prepareDescription(ctx context.Context, event *domain.Event) error {
+	if err := event.RenderDescription(); err != nil {
+		return err
+	}
+
+	mentions, err := s.mentions.Resolve(ctx, event.Description)
+	if err != nil {
+		return err
+	}
+
+	event.Mentions = mentions
+	return nil
+}
+
 func (s Service) DeleteEvent(ctx context.Context, dto *dtos.DeleteEvent) (*domain.Event, error) {
 	const op = "services.event.management.deleteEvent"
 	log := s.log.With(slog.String("op", op))
@@ -155,9 +241,10 @@ func (s Service) DeleteEvent(ctx context.Context, dto *dtos.DeleteEvent) (*domai
 	if err != nil {
 		return nil, s.handleError("failed to delete event", log, err)
 	}
+	s.tags.TrackUsage(ctx, event.Tags, nil)
 
+	s.wg.Add(1)
 	go func() {
-		s.wg.Add(1)
 		defer s.wg.Done()
 
 		purgeCtx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
@@ -207,9 +294,51 @@ func (s Service) PublishEvent(ctx context.Context, eventId string, userId int64)
 		return nil, s.handleError("failed to update event", log, err)
 	}
 
+	s.notifyMentioned(ctx, updatedEvent, userId, false, nil)
+
+	if updatedEvent.AutoAnnounce {
+		s.wg.Add(1)
+		go func(event domain.Event) {
+			defer s.wg.Done()
+			s.announceEvent(&event)
+		}(*updatedEvent)
+	}
+
 	return updatedEvent, nil
 }
 
+/*
+announceEvent creates the announcement post of the event in every collaborator club, it is run in the background after the event is published.
+
+	The clubs which already have a post referencing the event are skipped, so publishing the event again doesn't duplicate the announcements.
+*/
+func (s Service) announceEvent(event *domain.Event) {
+	const op = "services.event.management.announceEvent"
+	log := s.log.With(slog.String("op", op), slog.String("event_id", event.ID))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 1*time.Minute)
+	defer cancel()
+
+	for _, club := range event.CollaboratorClubs {
+		announced, err := s.AnnouncementStorage.HasEventPost(ctx, event.ID, club.ID)
+		if err != nil {
+			log.Error("background: failed to check event announcement", logger.Err(err), slog.Int64("club_id", club.ID))
+			continue
+		}
+		if announced {
+			continue
+		}
+
+		post := domain.NewEventAnnouncement(primitive.NewObjectID().Hex(), event, club, time.Now())
+		_, err = s.AnnouncementStorage.CreatePost(ctx, post)
+		if err != nil {
+			log.Error("background: failed to create event announcement", logger.Err(err), slog.Int64("club_id", club.ID))
+			continue
+		}
+		s.tags.TrackUsage(ctx, nil, post.Tags)
+	}
+}
+
 func (s Service) SendToReview(ctx context.Context, eventId string, userId int64) (*domain.Event, error) {
 	const op = "services.event.management.sendToReview"
 	log := s.log.With(slog.String("op", op))
@@ -335,6 +464,93 @@ func (s Service) UnpublishEvent(ctx context.Context, eventId string, userId int6
 	return updatedEvent, nil
 }
 
+// TransferOwnership creates a pending ownership transfer, the new owner must accept it to take over the event
+func (s Service) TransferOwnership(ctx context.Context, dto *dtos.TransferOwnership) (*domain.Event, error) {
+	const op = "services.event.management.transferOwnership"
+	log := s.log.With(slog.String("op", op))
+
+	event, err := s.getEvent(ctx, dto.EventId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !(event.IsOwner(dto.UserId) || dto.IsAdmin) {
+		return nil, eventservice.ErrPermissionsDenied
+	}
+
+	err = event.RequestOwnershipTransfer(dto.NewOwnerId, dto.UserId)
+	if err != nil {
+		return nil, s.handleError("failed to request ownership transfer", log, err)
+	}
+
+	updateCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	updatedEvent, err := s.EventStorage.UpdateEvent(updateCtx, event)
+	if err != nil {
+		return nil, s.handleError("failed to update event", log, err)
+	}
+
+	return updatedEvent, nil
+}
+
+func (s Service) AcceptOwnershipTransfer(ctx context.Context, eventId string, userId int64) (*domain.Event, error) {
+	const op = "services.event.management.acceptOwnershipTransfer"
+	log := s.log.With(slog.String("op", op))
+
+	event, err := s.getEvent(ctx, eventId)
+	if err != nil {
+		return nil, err
+	}
+
+	err = event.AcceptOwnershipTransfer(userId)
+	if err != nil {
+		return nil, s.handleError("failed to accept ownership transfer", log, err)
+	}
+
+	updateCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	updatedEvent, err := s.EventStorage.UpdateEvent(updateCtx, event)
+	if err != nil {
+		return nil, s.handleError("failed to update event", log, err)
+	}
+
+	return updatedEvent, nil
+}
+
+// RejectOwnershipTransfer removes the pending ownership transfer, it can be done by the target, the owner or an admin
+func (s Service) RejectOwnershipTransfer(ctx context.Context, dto *dtos.TransferOwnership) (*domain.Event, error) {
+	const op = "services.event.management.rejectOwnershipTransfer"
+	log := s.log.With(slog.String("op", op))
+
+	event, err := s.getEvent(ctx, dto.EventId)
+	if err != nil {
+		return nil, err
+	}
+
+	transfer := event.PendingOwnershipTransfer
+	if transfer == nil {
+		return nil, eventservice.ErrOwnershipTransferNotFound
+	}
+
+	if !(transfer.ToId == dto.UserId || event.IsOwner(dto.UserId) || dto.IsAdmin) {
+		return nil, eventservice.ErrPermissionsDenied
+	}
+
+	err = event.CancelOwnershipTransfer()
+	if err != nil {
+		return nil, s.handleError("failed to cancel ownership transfer", log, err)
+	}
+
+	updateCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	updatedEvent, err := s.EventStorage.UpdateEvent(updateCtx, event)
+	if err != nil {
+		return nil, s.handleError("failed to update event", log, err)
+	}
+
+	return updatedEvent, nil
+}
+
 // FetchEventAndCheckOwner fetches an event and checks if the user is the owner
 func (s Service) FetchEventAndCheckOwner(ctx context.Context, eventId string, userId int64) (*domain.Event, error) {
 	const op = "services.event.management.fetchEventAndCheckOwner"
@@ -352,6 +568,23 @@ func (s Service) FetchEventAndCheckOwner(ctx context.Context, eventId string, us
 	return event, nil
 }
 
+// FetchEventAndCheckPermission fetches an event and checks if the user has the permission according to their organizer role
+func (s Service) FetchEventAndCheckPermission(ctx context.Context, eventId string, userId int64, permission domain.EventPermission) (*domain.Event, error) {
+	const op = "services.event.management.fetchEventAndCheckPermission"
+	log := s.log.With(slog.String("op", op))
+
+	event, err := s.EventStorage.GetEvent(ctx, eventId)
+	if err != nil {
+		return nil, s.handleError("failed to get event", log, err)
+	}
+
+	if !event.HasPermission(userId, permission) {
+		return nil, fmt.Errorf("%w: missing %s permission", eventservice.ErrPermissionsDenied, permission)
+	}
+
+	return event, nil
+}
+
 func (s Service) getEvent(ctx context.Context, eventId string) (*domain.Event, error) {
 	const op = "services.event.management.getEvent"
 	log := s.log.With(slog.String("op", op))
@@ -375,6 +608,16 @@ func (s Service) handleError(msg string, log *slog.Logger, err error) error {
 		return eventservice.ErrInvalidID
 	case errors.Is(err, domain.ErrEventIsNotApproved):
 		return eventservice.ErrEventIsNotApproved
+	case errors.Is(err, domain.ErrOwnershipTransferNotFound):
+		return eventservice.ErrOwnershipTransferNotFound
+	case errors.Is(err, domain.ErrOrganizerNotFound):
+		return eventservice.ErrOrganizerNotFound
+	case errors.Is(err, domain.ErrUserIsEventOwner):
+		return eventservice.ErrUserIsEventOwner
+	case errors.Is(err, domain.ErrUserIsFromAnotherClub):
+		return eventservice.ErrUserIsFromAnotherClub
+	case errors.Is(err, domain.ErrTooManyMentions):
+		return fmt.Errorf("%w: %w", eventservice.ErrEventInvalidFields, err)
 	default:
 		log.Error(msg, logger.Err(err))
 		return err
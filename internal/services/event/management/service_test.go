@@ -14,6 +14,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"io"
 	"log/slog"
+	"sync"
 	"testing"
 	"time"
 )
@@ -21,17 +22,48 @@ import (
 type suite struct {
 	ManagementService Service
 	mockStorage       *mocks.EventStorage
+	wg                *sync.WaitGroup
 }
 
+// newSuite mocks the event storage, the purgers, the announcements, the mentions and the tags accept any call
 func newSuite(t *testing.T) *suite {
 	t.Helper()
 
 	mockStorage := mocks.NewEventStorage(t)
 	log := slog.New(slog.NewTextHandler(io.Discard, nil))
 
+	participantsPurger := mocks.NewParticipantsPurger(t)
+	participantsPurger.On("PurgeParticipants", mock.Anything, mock.Anything).Return(nil).Maybe()
+	banRecordsPurger := mocks.NewBanRecordsPurger(t)
+	banRecordsPurger.On("PurgeBanRecords", mock.Anything, mock.Anything).Return(nil).Maybe()
+	invitePurger := mocks.NewInvitePurger(t)
+	invitePurger.On("PurgeInvites", mock.Anything, mock.Anything).Return(nil).Maybe()
+	announcements := mocks.NewAnnouncementStorage(t)
+	announcements.On("HasEventPost", mock.Anything, mock.Anything, mock.Anything).Return(true, nil).Maybe()
+
+	mentions := mocks.NewMentionResolver(t)
+	mentions.On("Resolve", mock.Anything, mock.Anything).Return(nil, nil).Maybe()
+	mentions.On("NotifyMentioned", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
+	tags := mocks.NewTagResolver(t)
+	tags.On("Resolve", mock.Anything, mock.Anything).
+		Return(func(_ context.Context, tags []string) ([]string, error) { return tags, nil }).Maybe()
+	tags.On("TrackUsage", mock.Anything, mock.Anything, mock.Anything).Maybe()
+
+	wg := &sync.WaitGroup{}
+	storages := Storages{
+		EventStorage:        mockStorage,
+		ParticipantsPurger:  participantsPurger,
+		BanRecordsPurger:    banRecordsPurger,
+		InvitePurger:        invitePurger,
+		AnnouncementStorage: announcements,
+	}
+	// the purges run in the background, they have to finish before the mocks assert their expectations
+	t.Cleanup(wg.Wait)
+
 	return &suite{
-		ManagementService: New(log, mockStorage),
+		ManagementService: New(log, wg, storages, mentions, tags),
 		mockStorage:       mockStorage,
+		wg:                wg,
 	}
 }
 
@@ -177,6 +209,8 @@ func TestService_UpdateEvent_HappyPath(t *testing.T) {
 		Status:             domain.EventStatusDraft,
 		Title:              dto.Title,
 		Description:        dto.Description,
+		DescriptionHTML:    "<p>updated description</p>\n",
+		Excerpt:            "updated description",
 		Type:               dto.Type,
 		Tags:               dto.Tags,
 		MaxParticipants:    dto.MaxParticipants,
@@ -228,7 +262,7 @@ func TestService_UpdateEvent_GetEventError(t *testing.T) {
 	suite.mockStorage.AssertExpectations(t)
 }
 
-func TestService_UpdateEvent_UserIsNotEventOwner(t *testing.T) {
+func TestService_UpdateEvent_UserWithoutPermission(t *testing.T) {
 	suite := newSuite(t)
 	ctx := context.Background()
 	dto := &dtos.UpdateEvent{
@@ -243,8 +277,9 @@ func TestService_UpdateEvent_UserIsNotEventOwner(t *testing.T) {
 
 	suite.mockStorage.On("GetEvent", mock.Anything, dto.EventId).Return(oldEvent, nil)
 
+	// the user is not an organizer either, so no role grants the update
 	_, err := suite.ManagementService.UpdateEvent(ctx, dto)
-	require.ErrorIs(t, err, eventservice.ErrUserIsNotEventOwner)
+	require.ErrorIs(t, err, eventservice.ErrPermissionsDenied)
 
 	suite.mockStorage.AssertExpectations(t)
 }
@@ -856,13 +891,14 @@ func TestService_SendToReview_HappyPath(t *testing.T) {
 	userId := int64(1)
 
 	onGetEvent := &domain.Event{
-		ID:        eventId,
-		OwnerId:   userId,
-		Type:      domain.EventTypeUniversity,
-		Status:    domain.EventStatusDraft,
-		Title:     "old title",
-		StartDate: time.Now(),
-		EndDate:   time.Now(),
+		ID:          eventId,
+		OwnerId:     userId,
+		Type:        domain.EventTypeUniversity,
+		Status:      domain.EventStatusDraft,
+		Title:       "old title",
+		Description: "old description",
+		StartDate:   time.Now(),
+		EndDate:     time.Now(),
 		CoverImages: []domain.CoverImage{
 			{
 				File: domain.File{
@@ -921,13 +957,14 @@ func TestService_SendToReview_FailPath(t *testing.T) {
 		{
 			name: "Event Is already sent to review",
 			onGetEvent: &domain.Event{
-				ID:        eventId,
-				OwnerId:   userId,
-				Status:    domain.EventStatusPending,
-				Type:      domain.EventTypeUniversity,
-				Title:     "title",
-				StartDate: time.Now(),
-				EndDate:   time.Now(),
+				ID:          eventId,
+				OwnerId:     userId,
+				Status:      domain.EventStatusPending,
+				Type:        domain.EventTypeUniversity,
+				Title:       "title",
+				Description: "description",
+				StartDate:   time.Now(),
+				EndDate:     time.Now(),
 				CoverImages: []domain.CoverImage{
 					{File: domain.File{Name: "cover image", Url: "cover image url", Type: "image"}, Position: 1},
 				},
@@ -970,13 +1007,14 @@ func TestService_SendToReview_InvalidStatus(t *testing.T) {
 			suite := newSuite(t)
 			ctx := context.Background()
 			onGetEvent := &domain.Event{
-				ID:        eventId,
-				OwnerId:   userId,
-				Status:    s,
-				Type:      domain.EventTypeUniversity,
-				Title:     "title",
-				StartDate: time.Now(),
-				EndDate:   time.Now(),
+				ID:          eventId,
+				OwnerId:     userId,
+				Status:      s,
+				Type:        domain.EventTypeUniversity,
+				Title:       "title",
+				Description: "description",
+				StartDate:   time.Now(),
+				EndDate:     time.Now(),
 				CoverImages: []domain.CoverImage{
 					{File: domain.File{Name: "cover image", Url: "cover image url", Type: "image"}, Position: 1},
 				},
@@ -1359,3 +1397,240 @@ func TestService_UnpublishEvent_FailPath(t *testing.T) {
 		})
 	}
 }
+
+func newOwnershipEvent() *domain.Event {
+	return &domain.Event{
+		ID:      "event_id",
+		ClubId:  10,
+		OwnerId: 1,
+		Organizers: []domain.Organizer{
+			{User: domain.User{ID: 1}, ClubId: 10, Role: domain.OrganizerRoleCoOwner},
+			{User: domain.User{ID: 2}, ClubId: 10, Role: domain.OrganizerRoleEditor},
+			{User: domain.User{ID: 3}, ClubId: 20, Role: domain.OrganizerRoleEditor},
+		},
+	}
+}
+
+func TestService_TransferOwnership_HappyPath(t *testing.T) {
+	suite := newSuite(t)
+
+	suite.mockStorage.On("GetEvent", mock.Anything, "event_id").Return(newOwnershipEvent(), nil)
+	suite.mockStorage.On("UpdateEvent", mock.AnythingOfType("*context.timerCtx"), mock.AnythingOfType("*domain.Event")).Return(
+		func(ctx context.Context, event *domain.Event) (*domain.Event, error) {
+			return event, nil
+		},
+	)
+
+	event, err := suite.ManagementService.TransferOwnership(context.Background(), &dtos.TransferOwnership{
+		EventId:    "event_id",
+		UserId:     1,
+		NewOwnerId: 2,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, event.PendingOwnershipTransfer)
+	assert.Equal(t, int64(1), event.PendingOwnershipTransfer.FromId)
+	assert.Equal(t, int64(2), event.PendingOwnershipTransfer.ToId)
+	assert.Equal(t, int64(1), event.OwnerId)
+
+	suite.mockStorage.AssertExpectations(t)
+}
+
+func TestService_TransferOwnership_FailPath(t *testing.T) {
+	tests := []struct {
+		name    string
+		dto     *dtos.TransferOwnership
+		wantErr error
+	}{
+		{
+			name:    "TransferOwnership returns error when user is not the owner",
+			dto:     &dtos.TransferOwnership{EventId: "event_id", UserId: 2, NewOwnerId: 2},
+			wantErr: eventservice.ErrPermissionsDenied,
+		},
+		{
+			name:    "TransferOwnership returns error when new owner is the owner",
+			dto:     &dtos.TransferOwnership{EventId: "event_id", UserId: 1, NewOwnerId: 1},
+			wantErr: eventservice.ErrUserIsEventOwner,
+		},
+		{
+			name:    "TransferOwnership returns error when new owner is not an organizer",
+			dto:     &dtos.TransferOwnership{EventId: "event_id", UserId: 1, NewOwnerId: 4},
+			wantErr: eventservice.ErrOrganizerNotFound,
+		},
+		{
+			name:    "TransferOwnership returns error when new owner is an organizer from the collaborator club",
+			dto:     &dtos.TransferOwnership{EventId: "event_id", UserId: 1, NewOwnerId: 3},
+			wantErr: eventservice.ErrUserIsFromAnotherClub,
+		},
+		{
+			name:    "TransferOwnership returns error when admin transfers to an organizer from the collaborator club",
+			dto:     &dtos.TransferOwnership{EventId: "event_id", UserId: 5, NewOwnerId: 3, IsAdmin: true},
+			wantErr: eventservice.ErrUserIsFromAnotherClub,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			suite := newSuite(t)
+
+			suite.mockStorage.On("GetEvent", mock.Anything, "event_id").Return(newOwnershipEvent(), nil)
+
+			_, err := suite.ManagementService.TransferOwnership(context.Background(), tt.dto)
+			require.ErrorIs(t, err, tt.wantErr)
+
+			suite.mockStorage.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_AcceptOwnershipTransfer_HappyPath(t *testing.T) {
+	suite := newSuite(t)
+
+	onGetEvent := newOwnershipEvent()
+	onGetEvent.PendingOwnershipTransfer = &domain.OwnershipTransfer{FromId: 1, ToId: 2, ByWhoId: 1}
+
+	suite.mockStorage.On("GetEvent", mock.Anything, "event_id").Return(onGetEvent, nil)
+	suite.mockStorage.On("UpdateEvent", mock.AnythingOfType("*context.timerCtx"), mock.AnythingOfType("*domain.Event")).Return(
+		func(ctx context.Context, event *domain.Event) (*domain.Event, error) {
+			return event, nil
+		},
+	)
+
+	event, err := suite.ManagementService.AcceptOwnershipTransfer(context.Background(), "event_id", 2)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), event.OwnerId)
+	assert.Nil(t, event.PendingOwnershipTransfer)
+	assert.Equal(t, domain.OrganizerRoleCoOwner, event.GetOrganizerById(2).Role)
+	assert.Equal(t, domain.DefaultOrganizerRole, event.GetOrganizerById(1).Role)
+	require.Len(t, event.OwnershipHistory, 1)
+	assert.Equal(t, int64(1), event.OwnershipHistory[0].FromId)
+
+	suite.mockStorage.AssertExpectations(t)
+}
+
+func TestService_AcceptOwnershipTransfer_FailPath(t *testing.T) {
+	tests := []struct {
+		name     string
+		transfer *domain.OwnershipTransfer
+		userId   int64
+		onGetErr error
+		wantErr  error
+	}{
+		{
+			name:     "AcceptOwnershipTransfer returns error when event is not found",
+			userId:   2,
+			onGetErr: storage.ErrEventNotFound,
+			wantErr:  eventservice.ErrEventNotFound,
+		},
+		{
+			name:    "AcceptOwnershipTransfer returns error when there is no pending transfer",
+			userId:  2,
+			wantErr: eventservice.ErrOwnershipTransferNotFound,
+		},
+		{
+			name:     "AcceptOwnershipTransfer returns error when user is not the transfer target",
+			transfer: &domain.OwnershipTransfer{FromId: 1, ToId: 2, ByWhoId: 1},
+			userId:   3,
+			wantErr:  eventservice.ErrOwnershipTransferNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			suite := newSuite(t)
+
+			var onGetEvent *domain.Event
+			if tt.onGetErr == nil {
+				onGetEvent = newOwnershipEvent()
+				onGetEvent.PendingOwnershipTransfer = tt.transfer
+			}
+			suite.mockStorage.On("GetEvent", mock.Anything, "event_id").Return(onGetEvent, tt.onGetErr)
+
+			_, err := suite.ManagementService.AcceptOwnershipTransfer(context.Background(), "event_id", tt.userId)
+			require.ErrorIs(t, err, tt.wantErr)
+
+			suite.mockStorage.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_RejectOwnershipTransfer_HappyPath(t *testing.T) {
+	tests := []struct {
+		name string
+		dto  *dtos.TransferOwnership
+	}{
+		{
+			name: "RejectOwnershipTransfer by the transfer target",
+			dto:  &dtos.TransferOwnership{EventId: "event_id", UserId: 2},
+		},
+		{
+			name: "RejectOwnershipTransfer by the owner",
+			dto:  &dtos.TransferOwnership{EventId: "event_id", UserId: 1},
+		},
+		{
+			name: "RejectOwnershipTransfer by an admin",
+			dto:  &dtos.TransferOwnership{EventId: "event_id", UserId: 5, IsAdmin: true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			suite := newSuite(t)
+
+			onGetEvent := newOwnershipEvent()
+			onGetEvent.PendingOwnershipTransfer = &domain.OwnershipTransfer{FromId: 1, ToId: 2, ByWhoId: 1}
+
+			suite.mockStorage.On("GetEvent", mock.Anything, "event_id").Return(onGetEvent, nil)
+			suite.mockStorage.On("UpdateEvent", mock.AnythingOfType("*context.timerCtx"), mock.AnythingOfType("*domain.Event")).Return(
+				func(ctx context.Context, event *domain.Event) (*domain.Event, error) {
+					return event, nil
+				},
+			)
+
+			event, err := suite.ManagementService.RejectOwnershipTransfer(context.Background(), tt.dto)
+			require.NoError(t, err)
+			assert.Nil(t, event.PendingOwnershipTransfer)
+			assert.Equal(t, int64(1), event.OwnerId)
+
+			suite.mockStorage.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_RejectOwnershipTransfer_FailPath(t *testing.T) {
+	tests := []struct {
+		name     string
+		transfer *domain.OwnershipTransfer
+		userId   int64
+		wantErr  error
+	}{
+		{
+			name:    "RejectOwnershipTransfer returns error when there is no pending transfer",
+			userId:  2,
+			wantErr: eventservice.ErrOwnershipTransferNotFound,
+		},
+		{
+			name:     "RejectOwnershipTransfer returns error when user is neither the target nor the owner",
+			transfer: &domain.OwnershipTransfer{FromId: 1, ToId: 2, ByWhoId: 1},
+			userId:   3,
+			wantErr:  eventservice.ErrPermissionsDenied,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			suite := newSuite(t)
+
+			onGetEvent := newOwnershipEvent()
+			onGetEvent.PendingOwnershipTransfer = tt.transfer
+			suite.mockStorage.On("GetEvent", mock.Anything, "event_id").Return(onGetEvent, nil)
+
+			_, err := suite.ManagementService.RejectOwnershipTransfer(context.Background(), &dtos.TransferOwnership{
+				EventId: "event_id",
+				UserId:  tt.userId,
+			})
+			require.ErrorIs(t, err, tt.wantErr)
+
+			suite.mockStorage.AssertExpectations(t)
+		})
+	}
+}
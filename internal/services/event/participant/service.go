@@ -173,7 +173,7 @@ func (s Service) KickParticipant(ctx context.Context, dto *dtos.KickParticipant)
 		return s.handleError("failed to get event", log, err)
 	}
 
-	if !event.IsOrganizer(dto.UserId) {
+	if !event.HasPermission(dto.UserId, domain.EventPermissionKickParticipant) {
 		return eventservice.ErrPermissionsDenied
 	}
 
@@ -218,7 +218,7 @@ func (s Service) BanParticipant(ctx context.Context, dto *dtos.BanParticipant) (
 		return nil, s.handleError("failed to get event", log, err)
 	}
 
-	if !event.IsOrganizer(dto.UserId) {
+	if !event.HasPermission(dto.UserId, domain.EventPermissionBanParticipant) {
 		return nil, eventservice.ErrPermissionsDenied
 	}
 
@@ -284,7 +284,7 @@ func (s Service) UnbanParticipant(ctx context.Context, dto *dtos.UnbanParticipan
 	if err != nil {
 		return nil, s.handleError("failed to get event", log, err)
 	}
-	if !event.IsOrganizer(dto.UserId) {
+	if !event.HasPermission(dto.UserId, domain.EventPermissionBanParticipant) {
 		return nil, eventservice.ErrPermissionsDenied
 	}
 
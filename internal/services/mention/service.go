@@ -0,0 +1,157 @@
+package mentionservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/arumandesu/uniclubs-posts-service/internal/client/club"
+	userclient "github.com/arumandesu/uniclubs-posts-service/internal/client/user"
+	"github.com/arumandesu/uniclubs-posts-service/internal/domain"
+	"github.com/arumandesu/uniclubs-posts-service/internal/rabbitmq"
+	"github.com/arumandesu/uniclubs-posts-service/pkg/logger"
+	"log/slog"
+	"time"
+)
+
+// MentionMessageVersion must be increased on every breaking change of the MentionMessage payload
+const MentionMessageVersion = 1
+
+// MentionMessage is published to the posts exchange for every user newly mentioned in the post or event
+type MentionMessage struct {
+	Version    int       `json:"version"`
+	TargetType string    `json:"target_type"`
+	TargetId   string    `json:"target_id"`
+	Title      string    `json:"title,omitempty"`
+	ClubId     int64     `json:"club_id,omitempty"`
+	AuthorId   int64     `json:"author_id"`
+	UserId     int64     `json:"user_id"`
+	OccurredAt time.Time `json:"occurred_at"`
+}
+
+type Service struct {
+	log          *slog.Logger
+	userProvider UserProvider
+	clubProvider ClubProvider
+	publisher    Publisher
+}
+
+type UserProvider interface {
+	GetUserById(ctx context.Context, id int64) (*domain.User, error)
+}
+
+type ClubProvider interface {
+	GetClubById(ctx context.Context, clubId int64) (*domain.Club, error)
+}
+
+type Publisher interface {
+	Publish(ctx context.Context, exchangeName string, routingKey string, msg any) error
+}
+
+func New(log *slog.Logger, userProvider UserProvider, clubProvider ClubProvider, publisher Publisher) *Service {
+	return &Service{
+		log:          log,
+		userProvider: userProvider,
+		clubProvider: clubProvider,
+		publisher:    publisher,
+	}
+}
+
+// Resolve parses the mentions of the text and fills their names, the mentions of unknown users and clubs are dropped
+func (s Service) Resolve(ctx context.Context, text string) ([]domain.Mention, error) {
+	const op = "services.mention.resolve"
+	log := s.log.With(slog.String("op", op))
+
+	parsed, err := domain.ParseMentions(text)
+	if err != nil {
+		return nil, err
+	}
+	if len(parsed) == 0 {
+		return nil, nil
+	}
+
+	// the text can mention the same user or club many times, each of them is looked up once
+	names := make(map[domain.Mention]string)
+	for _, mention := range parsed {
+		key := domain.Mention{Type: mention.Type, ID: mention.ID}
+		if _, ok := names[key]; ok {
+			continue
+		}
+
+		name, err := s.lookup(ctx, mention.Type, mention.ID)
+		if err != nil {
+			log.Error("failed to resolve mention", logger.Err(err), slog.String("type", mention.Type.String()), slog.Int64("id", mention.ID))
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		names[key] = name
+	}
+
+	mentions := make([]domain.Mention, 0, len(parsed))
+	for _, mention := range parsed {
+		name := names[domain.Mention{Type: mention.Type, ID: mention.ID}]
+		if name == "" {
+			continue
+		}
+		mention.Name = name
+		mentions = append(mentions, mention)
+	}
+
+	return mentions, nil
+}
+
+// lookup returns the name of the mentioned user or club, it is empty when they do not exist
+func (s Service) lookup(ctx context.Context, mentionType domain.MentionType, id int64) (string, error) {
+	switch mentionType {
+	case domain.MentionUser:
+		user, err := s.userProvider.GetUserById(ctx, id)
+		if errors.Is(err, userclient.ErrUserNotFound) || errors.Is(err, userclient.ErrInvalidArg) {
+			return "", nil
+		}
+		if err != nil {
+			return "", err
+		}
+		return user.FirstName + " " + user.LastName, nil
+	case domain.MentionClub:
+		c, err := s.clubProvider.GetClubById(ctx, id)
+		if errors.Is(err, club.ErrClubNotFound) || errors.Is(err, club.ErrInvalidArg) {
+			return "", nil
+		}
+		if err != nil {
+			return "", err
+		}
+		return c.Name, nil
+	default:
+		return "", nil
+	}
+}
+
+// NotifyMentioned publishes the mention message for every user mentioned in current but not in previous,
+// failures are only logged because the text is already saved
+func (s Service) NotifyMentioned(ctx context.Context, source domain.MentionSource, previous, current []domain.Mention) {
+	const op = "services.mention.notifyMentioned"
+	log := s.log.With(slog.String("op", op))
+
+	now := time.Now()
+	for _, userId := range domain.NewlyMentionedUsers(previous, current) {
+		if userId == source.AuthorId {
+			continue
+		}
+
+		msg := MentionMessage{
+			Version:    MentionMessageVersion,
+			TargetType: source.TargetType.String(),
+			TargetId:   source.TargetId,
+			Title:      source.Title,
+			ClubId:     source.ClubId,
+			AuthorId:   source.AuthorId,
+			UserId:     userId,
+			OccurredAt: now,
+		}
+
+		publishCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+		err := s.publisher.Publish(publishCtx, rabbitmq.PostsExchangeName, rabbitmq.MentionCreatedRoutingKey, msg)
+		cancel()
+		if err != nil {
+			log.Warn("failed to publish message", logger.Err(err), slog.Int64("user_id", userId))
+		}
+	}
+}
@@ -0,0 +1,44 @@
+package postinfo
+
+import (
+	"context"
+	"fmt"
+	"github.com/arumandesu/uniclubs-posts-service/internal/domain"
+	dtos "github.com/arumandesu/uniclubs-posts-service/internal/domain/dto"
+	postservice "github.com/arumandesu/uniclubs-posts-service/internal/services/post"
+	"log/slog"
+)
+
+/*
+ListClubFeed returns the posts and the events of the club as one stream ordered by the publication time, the newest first.
+
+	The restricted posts and the events hidden from non-members are listed according to the user audience of the club.
+	The page size defaults to domain.MaxFeedPageSize, the next page is requested with the returned cursor.
+*/
+func (s Service) ListClubFeed(ctx context.Context, dto *dtos.ListClubFeed) ([]domain.FeedItem, *domain.PaginationMetadata, error) {
+	const op = "services.post.info.listClubFeed"
+	log := s.log.With(slog.String("op", op))
+
+	if dto.ClubId == 0 {
+		return nil, nil, fmt.Errorf("%w: missing club id", postservice.ErrInvalidArg)
+	}
+	if dto.PageSize <= 0 || dto.PageSize > domain.MaxFeedPageSize {
+		dto.PageSize = domain.MaxFeedPageSize
+	}
+	if dto.Page <= 0 {
+		dto.Page = 1
+	}
+
+	audience, err := s.clubAudience(ctx, dto.UserId, dto.ClubId)
+	if err != nil {
+		return nil, nil, postservice.HandleError(log, "failed to check club membership", err)
+	}
+	dto.Audience = audience
+
+	items, metadata, err := s.postProvider.ListClubFeed(ctx, dto)
+	if err != nil {
+		return nil, nil, postservice.HandleError(log, "failed to list club feed", err)
+	}
+
+	return items, metadata, nil
+}
@@ -2,37 +2,97 @@ package postinfo
 
 import (
 	"context"
+	"errors"
 	clubv1 "github.com/ARUMANDESU/uniclubs-protos/gen/go/club"
 	"github.com/arumandesu/uniclubs-posts-service/internal/domain"
 	dtos "github.com/arumandesu/uniclubs-posts-service/internal/domain/dto"
 	postservice "github.com/arumandesu/uniclubs-posts-service/internal/services/post"
+	"github.com/arumandesu/uniclubs-posts-service/internal/storage"
+	"github.com/arumandesu/uniclubs-posts-service/pkg/logger"
 	"log/slog"
+	"slices"
+	"sync"
+	"time"
 )
 
+// maxConcurrentAudienceChecks limits the club service calls made at once while resolving the user audience of the posts list
+const maxConcurrentAudienceChecks = 8
+
 type Service struct {
-	log          *slog.Logger
-	postProvider PostProvider
-	clubProvider ClubProvider
+	log              *slog.Logger
+	wg               *sync.WaitGroup
+	postProvider     PostProvider
+	clubProvider     ClubProvider
+	reactionProvider ReactionProvider
+	voteProvider     VoteProvider
+	eventProvider    EventProvider
+	viewStorage      ViewStorage
+	tags             TagResolver
 }
 
 type PostProvider interface {
 	GetPostById(ctx context.Context, postId string) (*domain.Post, error)
 	ListPosts(ctx context.Context, filters *dtos.ListPostsRequest) ([]domain.Post, *domain.PaginationMetadata, error)
+	GetRestrictedPostsClubIds(ctx context.Context, filters *dtos.ListPostsRequest) ([]int64, error)
+	ListClubFeed(ctx context.Context, dto *dtos.ListClubFeed) ([]domain.FeedItem, *domain.PaginationMetadata, error)
 }
 
 type ClubProvider interface {
 	HasPermission(ctx context.Context, userId, clubId int64, permission clubv1.Permission) (bool, error)
+	IsClubMember(ctx context.Context, userId, clubId int64) (bool, error)
+	GetUserClubs(ctx context.Context, userId int64) ([]domain.Club, error)
+}
+
+type ReactionProvider interface {
+	GetUserReactions(ctx context.Context, targetType domain.TargetType, targetId string, userId int64) ([]domain.ReactionType, error)
+}
+
+type VoteProvider interface {
+	GetUserVote(ctx context.Context, postId string, userId int64) (*domain.Vote, error)
+	GetPollTallies(ctx context.Context, postId string, withVoters bool) ([]domain.PollOptionResult, int64, error)
+}
+
+type EventProvider interface {
+	GetEventsByIds(ctx context.Context, ids []string) ([]domain.Event, error)
 }
 
-func New(log *slog.Logger, postProvider PostProvider, clubProvider ClubProvider) *Service {
+// TagResolver maps the filter tags to the canonical ones, so the merged tags match the retagged posts
+type TagResolver interface {
+	Resolve(ctx context.Context, tags []string) ([]string, error)
+}
+
+type ViewStorage interface {
+	RecordPostView(ctx context.Context, view *domain.PostView) error
+	GetPostStats(ctx context.Context, postId string, from, to time.Time) (*domain.PostStats, error)
+}
+
+// New creates the post info service, wg tracks the views recorded in the background so the app waits for them on stop
+func New(
+	log *slog.Logger,
+	wg *sync.WaitGroup,
+	postProvider PostProvider,
+	clubProvider ClubProvider,
+	reactionProvider ReactionProvider,
+	voteProvider VoteProvider,
+	eventProvider EventProvider,
+	viewStorage ViewStorage,
+	tags TagResolver,
+) *Service {
 	return &Service{
-		log:          log,
-		postProvider: postProvider,
-		clubProvider: clubProvider,
+		log:              log,
+		wg:               wg,
+		postProvider:     postProvider,
+		clubProvider:     clubProvider,
+		reactionProvider: reactionProvider,
+		voteProvider:     voteProvider,
+		eventProvider:    eventProvider,
+		viewStorage:      viewStorage,
+		tags:             tags,
 	}
 }
 
-func (s Service) GetPost(ctx context.Context, postId string, userId int64) (*domain.Post, error) {
+// GetPost returns the post visible to the user and counts the view, source is the place the post was opened from
+func (s Service) GetPost(ctx context.Context, postId string, userId int64, source domain.ViewSource) (*dtos.GetPost, error) {
 	const op = "services.post.info.getPost"
 	log := s.log.With(slog.String("op", op))
 
@@ -41,13 +101,179 @@ func (s Service) GetPost(ctx context.Context, postId string, userId int64) (*dom
 		return nil, postservice.HandleError(log, "failed to get post", err)
 	}
 
-	return post, nil
+	if post.NeedsAudience() {
+		audience, err := s.clubAudience(ctx, userId, post.Club.ID)
+		if err != nil {
+			return nil, postservice.HandleError(log, "failed to check club membership", err)
+		}
+		if !post.IsReadableBy(audience) {
+			return nil, postservice.ErrPostNotFound
+		}
+	}
+
+	s.recordView(&domain.PostView{PostId: post.ID, UserId: userId, Source: source, ViewedAt: time.Now()})
+
+	var userReactions []domain.ReactionType
+	if userId != 0 {
+		userReactions, err = s.reactionProvider.GetUserReactions(ctx, domain.TargetPost, post.ID, userId)
+		if err != nil {
+			return nil, postservice.HandleError(log, "failed to get user reactions", err)
+		}
+	}
+
+	dto := &dtos.GetPost{
+		Post:          *post,
+		UserReactions: userReactions,
+	}
+
+	if len(post.EventIds) > 0 {
+		dto.Events, err = s.eventSummaries(ctx, post.EventIds, userId)
+		if err != nil {
+			return nil, postservice.HandleError(log, "failed to get referenced events", err)
+		}
+	}
+
+	if post.IsPoll() {
+		err = s.fillPoll(ctx, dto, userId)
+		if err != nil {
+			return nil, postservice.HandleError(log, "failed to get poll results", err)
+		}
+	}
+
+	return dto, nil
+}
+
+/*
+recordView counts the view in the background so reading the post is not slowed down, failures are only logged.
+
+	The write is tracked by the app WaitGroup, the app waits for it on stop before the storage is closed.
+*/
+func (s Service) recordView(view *domain.PostView) {
+	const op = "services.post.info.recordView"
+	log := s.log.With(slog.String("op", op))
+
+	if !view.Source.IsValid() {
+		view.Source = domain.ViewSourceDirect
+	}
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		if err := s.viewStorage.RecordPostView(ctx, view); err != nil {
+			log.Warn("failed to record post view", logger.Err(err), slog.String("post_id", view.PostId))
+		}
+	}()
+}
+
+/*
+eventSummaries returns the summaries of the events in the given order, draft and deleted events are skipped.
+
+	The events hidden for non-members are skipped unless the user is their organizer or a member of their clubs,
+	the clubs of the user are requested only when such an event is referenced.
+*/
+func (s Service) eventSummaries(ctx context.Context, eventIds []string, userId int64) ([]domain.EventSummary, error) {
+	events, err := s.eventProvider.GetEventsByIds(ctx, eventIds)
+	if err != nil {
+		return nil, err
+	}
+
+	byId := make(map[string]domain.Event, len(events))
+	for _, event := range events {
+		byId[event.ID] = event
+	}
+
+	var memberClubIds []int64
+	membershipResolved := userId == 0
+
+	summaries := make([]domain.EventSummary, 0, len(events))
+	for _, eventId := range eventIds {
+		event, ok := byId[eventId]
+		if !ok || event.Status == domain.EventStatusDraft {
+			continue
+		}
+		if event.IsHiddenForNonMembers && !event.IsOrganizer(userId) && !membershipResolved {
+			memberClubIds, err = s.memberClubIds(ctx, userId)
+			if err != nil {
+				return nil, err
+			}
+			membershipResolved = true
+		}
+		if !event.IsVisibleToMember(userId, memberClubIds) {
+			continue
+		}
+		summaries = append(summaries, event.Summary())
+	}
+
+	return summaries, nil
+}
+
+// memberClubIds returns the ids of the clubs the user is a member of
+func (s Service) memberClubIds(ctx context.Context, userId int64) ([]int64, error) {
+	clubs, err := s.clubProvider.GetUserClubs(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	clubIds := make([]int64, len(clubs))
+	for i, club := range clubs {
+		clubIds[i] = club.ID
+	}
+	return clubIds, nil
+}
+
+// fillPoll sets the vote of the user and the poll results if they are visible to the user
+func (s Service) fillPoll(ctx context.Context, dto *dtos.GetPost, userId int64) error {
+	poll := dto.Post.Poll
+
+	if userId != 0 {
+		vote, err := s.voteProvider.GetUserVote(ctx, dto.Post.ID, userId)
+		if err != nil && !errors.Is(err, storage.ErrVoteNotFound) {
+			return err
+		}
+		if vote != nil {
+			dto.UserVote = vote.OptionIds
+		}
+	}
+
+	if !poll.ResultsVisible(dto.UserVote != nil, time.Now()) {
+		return nil
+	}
+
+	tallies, totalVoters, err := s.voteProvider.GetPollTallies(ctx, dto.Post.ID, !poll.Anonymous)
+	if err != nil {
+		return err
+	}
+
+	results := poll.Results(tallies, totalVoters)
+	dto.PollResults = &results
+	return nil
 }
 
 func (s Service) ListPosts(ctx context.Context, filter *dtos.ListPostsRequest) ([]domain.Post, *domain.PaginationMetadata, error) {
 	const op = "services.post.info.listPosts"
 	log := s.log.With(slog.String("op", op))
 
+	canManage, err := s.canManagePosts(ctx, filter.UserId, filter.ClubId)
+	if err != nil {
+		return nil, nil, postservice.HandleError(log, "failed to check permission", err)
+	}
+	filter.IncludeHidden = canManage
+	filter.IncludeUnpublished = canManage
+
+	filter.Tags, err = s.tags.Resolve(ctx, filter.Tags)
+	if err != nil {
+		return nil, nil, postservice.HandleError(log, "failed to resolve tags", err)
+	}
+
+	err = s.resolveAudience(ctx, filter, canManage)
+	if err != nil {
+		return nil, nil, postservice.HandleError(log, "failed to check club membership", err)
+	}
+
 	posts, metadata, err := s.postProvider.ListPosts(ctx, filter)
 	if err != nil {
 		return nil, nil, postservice.HandleError(log, "failed to list posts", err)
@@ -55,3 +281,105 @@ func (s Service) ListPosts(ctx context.Context, filter *dtos.ListPostsRequest) (
 
 	return posts, metadata, nil
 }
+
+func (s Service) canManagePosts(ctx context.Context, userId, clubId int64) (bool, error) {
+	if userId == 0 || clubId == 0 {
+		return false, nil
+	}
+
+	return s.clubProvider.HasPermission(ctx, userId, clubId, clubv1.Permission_PERMISSION_MANAGE_POSTS)
+}
+
+func (s Service) clubAudience(ctx context.Context, userId, clubId int64) (domain.PostAudience, error) {
+	return postservice.ClubAudience(ctx, s.clubProvider, userId, clubId)
+}
+
+/*
+resolveAudience sets the clubs whose members only and managers only posts are listed to the user.
+
+	The membership is checked once per club instead of once per post:
+	the club posts list checks only the club itself, the other lists take the clubs of the user with a single call
+	and check the permission only for the clubs the user is a member of which have restricted posts matching the filter.
+*/
+func (s Service) resolveAudience(ctx context.Context, filter *dtos.ListPostsRequest, canManage bool) error {
+	if filter.UserId == 0 {
+		return nil
+	}
+
+	if filter.ClubId != 0 {
+		if canManage {
+			filter.ManagedClubIds = []int64{filter.ClubId}
+			return nil
+		}
+		isMember, err := s.clubProvider.IsClubMember(ctx, filter.UserId, filter.ClubId)
+		if err != nil {
+			return err
+		}
+		if isMember {
+			filter.MemberClubIds = []int64{filter.ClubId}
+		}
+		return nil
+	}
+
+	restrictedClubIds, err := s.postProvider.GetRestrictedPostsClubIds(ctx, filter)
+	if err != nil {
+		return err
+	}
+	if len(restrictedClubIds) == 0 {
+		return nil
+	}
+
+	memberClubIds, err := s.memberClubIds(ctx, filter.UserId)
+	if err != nil {
+		return err
+	}
+
+	var clubIds []int64
+	for _, clubId := range memberClubIds {
+		if slices.Contains(restrictedClubIds, clubId) {
+			clubIds = append(clubIds, clubId)
+		}
+	}
+	if len(clubIds) == 0 {
+		return nil
+	}
+
+	var (
+		wg  sync.WaitGroup
+		mu  sync.Mutex
+		sem = make(chan struct{}, maxConcurrentAudienceChecks)
+	)
+	errCh := make(chan error, len(clubIds))
+
+	for _, clubId := range clubIds {
+		wg.Add(1)
+		go func(clubId int64) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			canManage, err := s.clubProvider.HasPermission(ctx, filter.UserId, clubId, clubv1.Permission_PERMISSION_MANAGE_POSTS)
+			if err != nil {
+				errCh <- err
+				return
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			if canManage {
+				filter.ManagedClubIds = append(filter.ManagedClubIds, clubId)
+			} else {
+				filter.MemberClubIds = append(filter.MemberClubIds, clubId)
+			}
+		}(clubId)
+	}
+
+	wg.Wait()
+	close(errCh)
+
+	if err := <-errCh; err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,57 @@
+package postinfo
+
+import (
+	"context"
+	"fmt"
+	clubv1 "github.com/ARUMANDESU/uniclubs-protos/gen/go/club"
+	"github.com/arumandesu/uniclubs-posts-service/internal/domain"
+	dtos "github.com/arumandesu/uniclubs-posts-service/internal/domain/dto"
+	postservice "github.com/arumandesu/uniclubs-posts-service/internal/services/post"
+	"log/slog"
+	"time"
+)
+
+const (
+	defaultStatsPeriod = 30 * 24 * time.Hour
+	maxStatsPeriod     = 366 * 24 * time.Hour
+)
+
+// GetPostStats returns the views of the post over the period, it is available to the club post managers only
+func (s Service) GetPostStats(ctx context.Context, dto *dtos.GetPostStats) (*domain.PostStats, error) {
+	const op = "services.post.info.getPostStats"
+	log := s.log.With(slog.String("op", op))
+
+	post, err := s.postProvider.GetPostById(ctx, dto.PostId)
+	if err != nil {
+		return nil, postservice.HandleError(log, "failed to get post", err)
+	}
+
+	hasPermission, err := s.clubProvider.HasPermission(ctx, dto.UserId, post.Club.ID, clubv1.Permission_PERMISSION_MANAGE_POSTS)
+	if err != nil {
+		return nil, postservice.HandleError(log, "failed to check permission", err)
+	}
+	if !hasPermission {
+		return nil, fmt.Errorf("%w: user %d does not have permission to manage posts in club %d", postservice.ErrPermissionDenied, dto.UserId, post.Club.ID)
+	}
+
+	from, to := dto.From, dto.To
+	if to.IsZero() {
+		to = time.Now()
+	}
+	if from.IsZero() {
+		from = to.Add(-defaultStatsPeriod)
+	}
+	if from.After(to) {
+		return nil, fmt.Errorf("%w: from must be before to", postservice.ErrInvalidArg)
+	}
+	if to.Sub(from) > maxStatsPeriod {
+		return nil, fmt.Errorf("%w: stats period must not be longer than %d days", postservice.ErrInvalidArg, int(maxStatsPeriod.Hours()/24))
+	}
+
+	stats, err := s.viewStorage.GetPostStats(ctx, post.ID, from, to)
+	if err != nil {
+		return nil, postservice.HandleError(log, "failed to get post stats", err)
+	}
+
+	return stats, nil
+}
@@ -0,0 +1,122 @@
+package postmanagement
+
+import (
+	"context"
+	"fmt"
+	clubv1 "github.com/ARUMANDESU/uniclubs-protos/gen/go/club"
+	"github.com/arumandesu/uniclubs-posts-service/internal/domain"
+	dtos "github.com/arumandesu/uniclubs-posts-service/internal/domain/dto"
+	postservice "github.com/arumandesu/uniclubs-posts-service/internal/services/post"
+	"github.com/arumandesu/uniclubs-posts-service/pkg/logger"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"log/slog"
+	"time"
+)
+
+// ListPostRevisions returns the post content revisions, the latest ones first
+func (s Service) ListPostRevisions(ctx context.Context, dto *dtos.ListPostRevisions) ([]domain.PostRevision, *domain.PaginationMetadata, error) {
+	const op = "services.post.management.listPostRevisions"
+	log := s.log.With(slog.String("op", op))
+
+	post, err := s.postStorage.GetPostById(ctx, dto.PostId)
+	if err != nil {
+		return nil, nil, postservice.HandleError(log, "failed to get post by id", err)
+	}
+
+	hasPermission, err := s.clubProvider.HasPermission(ctx, dto.UserId, post.Club.ID, clubv1.Permission_PERMISSION_MANAGE_POSTS)
+	if err != nil {
+		return nil, nil, postservice.HandleError(log, "failed to check permission", err)
+	}
+	if !hasPermission {
+		return nil, nil, fmt.Errorf("%w: user %d does not have permission to manage posts in club %d", postservice.ErrPermissionDenied, dto.UserId, post.Club.ID)
+	}
+
+	revisions, metadata, err := s.postStorage.ListPostRevisions(ctx, dto)
+	if err != nil {
+		return nil, nil, postservice.HandleError(log, "failed to list post revisions", err)
+	}
+
+	return revisions, metadata, nil
+}
+
+// RestorePostRevision reverts the post content to the one stored in the revision, the restore itself is recorded as a new revision
+func (s Service) RestorePostRevision(ctx context.Context, dto *dtos.RestorePostRevision) (*domain.Post, error) {
+	const op = "services.post.management.restorePostRevision"
+	log := s.log.With(slog.String("op", op))
+
+	post, err := s.postStorage.GetPostById(ctx, dto.PostId)
+	if err != nil {
+		return nil, postservice.HandleError(log, "failed to get post by id", err)
+	}
+
+	hasPermission, err := s.clubProvider.HasPermission(ctx, dto.UserId, post.Club.ID, clubv1.Permission_PERMISSION_MANAGE_POSTS)
+	if err != nil {
+		return nil, postservice.HandleError(log, "failed to check permission", err)
+	}
+	if !hasPermission {
+		return nil, fmt.Errorf("%w: user %d does not have permission to manage posts in club %d", postservice.ErrPermissionDenied, dto.UserId, post.Club.ID)
+	}
+
+	revision, err := s.postStorage.GetPostRevision(ctx, dto.RevisionId)
+	if err != nil {
+		return nil, postservice.HandleError(log, "failed to get post revision", err)
+	}
+	if revision.PostId != post.ID {
+		return nil, fmt.Errorf("%w: revision %s does not belong to post %s", postservice.ErrRevisionNotFound, revision.ID, post.ID)
+	}
+
+	before := post.Content()
+	previousMentions := post.Mentions
+	wasPublished := post.IsPublished()
+	post.SetContent(revision.Content)
+	if err = s.prepareDescription(ctx, post); err != nil {
+		return nil, postservice.HandleError(log, "failed to prepare description", err)
+	}
+	// the revision can predate a tag merge, its tags are resolved to the current canonical ones
+	post.Tags, err = s.tags.Resolve(ctx, post.Tags)
+	if err != nil {
+		return nil, postservice.HandleError(log, "failed to resolve tags", err)
+	}
+
+	post, err = s.updatePostWithRevision(ctx, log, before, post, dto.UserId, revision.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.notifyMentioned(ctx, post, dto.UserId, wasPublished, previousMentions)
+	s.tags.TrackUsage(ctx, before.Tags, post.Tags)
+
+	return post, nil
+}
+
+/*
+updatePostWithRevision stores the revision of the post content update and then the update itself,
+so no update is stored without its revision.
+
+	The revision is removed when the update fails, e.g. on the optimistic locking conflict.
+	Updates which don't change the content have no revision.
+*/
+func (s Service) updatePostWithRevision(ctx context.Context, log *slog.Logger, before domain.PostContent, post *domain.Post, editorId int64, restoredFrom string) (*domain.Post, error) {
+	revision := domain.NewPostRevision(primitive.NewObjectID().Hex(), before, post, editorId, time.Now())
+	if revision != nil {
+		revision.RestoredFrom = restoredFrom
+		if err := s.postStorage.CreatePostRevision(ctx, revision); err != nil {
+			return nil, postservice.HandleError(log, "failed to create post revision", err)
+		}
+	}
+
+	updated, err := s.postStorage.UpdatePost(ctx, post)
+	if err != nil {
+		if revision != nil {
+			// the request context can be already canceled, the revision is removed anyway
+			deleteCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 5*time.Second)
+			defer cancel()
+			if deleteErr := s.postStorage.DeletePostRevision(deleteCtx, revision.ID); deleteErr != nil {
+				log.Error("failed to delete the revision of the failed update", logger.Err(deleteErr), slog.String("revision_id", revision.ID))
+			}
+		}
+		return nil, postservice.HandleError(log, "failed to update post", err)
+	}
+
+	return updated, nil
+}
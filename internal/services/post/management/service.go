@@ -2,29 +2,49 @@ package postmanagement
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	clubv1 "github.com/ARUMANDESU/uniclubs-protos/gen/go/club"
 	"github.com/arumandesu/uniclubs-posts-service/internal/domain"
 	dtos "github.com/arumandesu/uniclubs-posts-service/internal/domain/dto"
 	postservice "github.com/arumandesu/uniclubs-posts-service/internal/services/post"
+	"github.com/arumandesu/uniclubs-posts-service/internal/storage"
+	"github.com/arumandesu/uniclubs-posts-service/pkg/logger"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"log/slog"
 	"time"
 )
 
 type Service struct {
-	log          *slog.Logger
-	postStorage  PostStorage
-	clubProvider ClubProvider
+	log            *slog.Logger
+	postStorage    PostStorage
+	clubProvider   ClubProvider
+	eventProvider  EventProvider
+	mentions       MentionResolver
+	tags           TagResolver
+	maxPinnedPosts int
+	restoreWindow  time.Duration
 }
 
 type PostStorage interface {
 	CreatePost(ctx context.Context, post *domain.Post) (*domain.Post, error)
 	UpdatePost(ctx context.Context, post *domain.Post) (*domain.Post, error)
-	DeletePost(ctx context.Context, postId string) (*domain.Post, error)
-	HidePost(ctx context.Context, postId string) (*domain.Post, error)
+	DeletePost(ctx context.Context, postId string, userId int64) (*domain.Post, error)
+	RestorePost(ctx context.Context, postId string) (*domain.Post, error)
+	PurgeDeletedPosts(ctx context.Context, before time.Time) (int64, error)
+	HidePost(ctx context.Context, postId string, userId int64) (*domain.Post, error)
 	UnhidePost(ctx context.Context, postId string) (*domain.Post, error)
 	GetPostById(ctx context.Context, postId string) (*domain.Post, error)
+	GetDeletedPostById(ctx context.Context, postId string) (*domain.Post, error)
+	PublishScheduledPosts(ctx context.Context, now time.Time) ([]domain.Post, error)
+	ExpirePosts(ctx context.Context, now time.Time) (int64, error)
+	PinPost(ctx context.Context, clubId int64, postId string, userId int64, expiresAt time.Time, limit int) (*domain.Post, error)
+	UnpinPost(ctx context.Context, postId string) (*domain.Post, error)
+	UnpinExpiredPosts(ctx context.Context, now time.Time) (int64, error)
+	CreatePostRevision(ctx context.Context, revision *domain.PostRevision) error
+	DeletePostRevision(ctx context.Context, revisionId string) error
+	GetPostRevision(ctx context.Context, revisionId string) (*domain.PostRevision, error)
+	ListPostRevisions(ctx context.Context, dto *dtos.ListPostRevisions) ([]domain.PostRevision, *domain.PaginationMetadata, error)
 }
 
 type ClubProvider interface {
@@ -32,11 +52,42 @@ type ClubProvider interface {
 	HasPermission(ctx context.Context, userId, clubId int64, permission clubv1.Permission) (bool, error)
 }
 
-func New(log *slog.Logger, postStorage PostStorage, clubProvider ClubProvider) *Service {
+type EventProvider interface {
+	GetEventsByIds(ctx context.Context, ids []string) ([]domain.Event, error)
+}
+
+type MentionResolver interface {
+	Resolve(ctx context.Context, text string) ([]domain.Mention, error)
+	NotifyMentioned(ctx context.Context, source domain.MentionSource, previous, current []domain.Mention)
+}
+
+// TagResolver replaces the tags with their canonical registry tags and keeps the tag usage counters
+type TagResolver interface {
+	Resolve(ctx context.Context, tags []string) ([]string, error)
+	TrackUsage(ctx context.Context, previous, current []string)
+}
+
+// New creates the post management service, maxPinnedPosts limits the number of pinned posts per club,
+// restoreWindow is the time during which the deleted posts can be restored before they are purged
+func New(
+	log *slog.Logger,
+	postStorage PostStorage,
+	clubProvider ClubProvider,
+	eventProvider EventProvider,
+	mentions MentionResolver,
+	tags TagResolver,
+	maxPinnedPosts int,
+	restoreWindow time.Duration,
+) *Service {
 	return &Service{
-		log:          log,
-		postStorage:  postStorage,
-		clubProvider: clubProvider,
+		log:            log,
+		postStorage:    postStorage,
+		clubProvider:   clubProvider,
+		eventProvider:  eventProvider,
+		mentions:       mentions,
+		tags:           tags,
+		maxPinnedPosts: maxPinnedPosts,
+		restoreWindow:  restoreWindow,
 	}
 }
 
@@ -60,10 +111,12 @@ func (s Service) CreatePost(ctx context.Context, dto *dtos.CreatePostRequest) (*
 	now := time.Now()
 
 	post := &domain.Post{
-		ID:        primitive.NewObjectID().Hex(),
-		Club:      *club,
-		CreatedAt: now,
-		UpdatedAt: now,
+		ID:         primitive.NewObjectID().Hex(),
+		Club:       *club,
+		Type:       domain.PostTypeText,
+		Visibility: domain.PostVisibilityPublic,
+		CreatedAt:  now,
+		UpdatedAt:  now,
 	}
 
 	if dto.Paths["title"] {
@@ -71,9 +124,15 @@ func (s Service) CreatePost(ctx context.Context, dto *dtos.CreatePostRequest) (*
 	}
 	if dto.Paths["description"] {
 		post.Description = dto.Description
+		if err = s.prepareDescription(ctx, post); err != nil {
+			return nil, postservice.HandleError(log, "failed to prepare description", err)
+		}
 	}
 	if dto.Paths["tags"] {
-		post.Tags = dto.Tags
+		post.Tags, err = s.tags.Resolve(ctx, dto.Tags)
+		if err != nil {
+			return nil, postservice.HandleError(log, "failed to resolve tags", err)
+		}
 	}
 	if dto.Paths["cover_images"] {
 		post.CoverImages = dto.CoverImages
@@ -82,11 +141,41 @@ func (s Service) CreatePost(ctx context.Context, dto *dtos.CreatePostRequest) (*
 		post.AttachedFiles = dto.AttachedFiles
 	}
 
+	if dto.Paths["event_ids"] {
+		if err = s.linkEvents(ctx, post, dto.EventIds); err != nil {
+			return nil, postservice.HandleError(log, "failed to link events", err)
+		}
+	}
+
+	if dto.Paths["visibility"] {
+		if err = post.SetVisibility(dto.Visibility); err != nil {
+			return nil, fmt.Errorf("%w: %w", postservice.ErrInvalidArg, err)
+		}
+	}
+
+	if dto.Paths["poll"] && dto.Poll != nil {
+		if err = post.AttachPoll(*dto.Poll, now); err != nil {
+			return nil, fmt.Errorf("%w: %w", postservice.ErrInvalidArg, err)
+		}
+	}
+
+	status := domain.PostStatusPublished
+	if dto.Paths["status"] {
+		status = dto.Status
+	}
+	err = post.SchedulePublication(status, dto.PublishAt, dto.ExpiresAt)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", postservice.ErrInvalidArg, err)
+	}
+
 	post, err = s.postStorage.CreatePost(ctx, post)
 	if err != nil {
 		return nil, postservice.HandleError(log, "failed to create post", err)
 	}
 
+	s.notifyMentioned(ctx, post, dto.UserId, false, nil)
+	s.tags.TrackUsage(ctx, nil, post.Tags)
+
 	return post, nil
 }
 
@@ -107,14 +196,24 @@ func (s Service) UpdatePost(ctx context.Context, dto *dtos.UpdatePostRequest) (*
 		return nil, fmt.Errorf("%w: user %d does not have permission to manage posts in club %d", postservice.ErrPermissionDenied, dto.UserId, post.Club.ID)
 	}
 
+	before := post.Content()
+	previousMentions := post.Mentions
+	wasPublished := post.IsPublished()
+
 	if dto.Paths["title"] {
 		post.Title = dto.Title
 	}
 	if dto.Paths["description"] {
 		post.Description = dto.Description
+		if err = s.prepareDescription(ctx, post); err != nil {
+			return nil, postservice.HandleError(log, "failed to prepare description", err)
+		}
 	}
 	if dto.Paths["tags"] {
-		post.Tags = dto.Tags
+		post.Tags, err = s.tags.Resolve(ctx, dto.Tags)
+		if err != nil {
+			return nil, postservice.HandleError(log, "failed to resolve tags", err)
+		}
 	}
 	if dto.Paths["cover_images"] {
 		post.CoverImages = dto.CoverImages
@@ -123,14 +222,51 @@ func (s Service) UpdatePost(ctx context.Context, dto *dtos.UpdatePostRequest) (*
 		post.AttachedFiles = dto.AttachedFiles
 	}
 
-	post, err = s.postStorage.UpdatePost(ctx, post)
+	if dto.Paths["event_ids"] {
+		if err = s.linkEvents(ctx, post, dto.EventIds); err != nil {
+			return nil, postservice.HandleError(log, "failed to link events", err)
+		}
+	}
+
+	if dto.Paths["visibility"] {
+		if err = post.SetVisibility(dto.Visibility); err != nil {
+			return nil, fmt.Errorf("%w: %w", postservice.ErrInvalidArg, err)
+		}
+	}
+
+	if dto.Paths["status"] || dto.Paths["publish_at"] || dto.Paths["expires_at"] {
+		status, publishAt, expiresAt := post.Status, post.PublishAt, post.ExpiresAt
+		if status == "" || status == domain.PostStatusExpired {
+			status = domain.PostStatusPublished
+		}
+		if dto.Paths["status"] {
+			status = dto.Status
+		}
+		if dto.Paths["publish_at"] {
+			publishAt = dto.PublishAt
+		}
+		if dto.Paths["expires_at"] {
+			expiresAt = dto.ExpiresAt
+		}
+
+		err = post.SchedulePublication(status, publishAt, expiresAt)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", postservice.ErrInvalidArg, err)
+		}
+	}
+
+	post, err = s.updatePostWithRevision(ctx, log, before, post, dto.UserId, "")
 	if err != nil {
-		return nil, postservice.HandleError(log, "failed to update post", err)
+		return nil, err
 	}
 
+	s.notifyMentioned(ctx, post, dto.UserId, wasPublished, previousMentions)
+	s.tags.TrackUsage(ctx, before.Tags, post.Tags)
+
 	return post, nil
 }
 
+// DeletePost soft deletes the post, it can be restored during the restore window
 func (s Service) DeletePost(ctx context.Context, dto *dtos.ActionRequest) (*domain.Post, error) {
 	const op = "services.post.management.deletePost"
 	log := s.log.With(slog.String("op", op))
@@ -148,20 +284,292 @@ func (s Service) DeletePost(ctx context.Context, dto *dtos.ActionRequest) (*doma
 		return nil, fmt.Errorf("%w: user %d does not have permission to manage posts in club %d", postservice.ErrPermissionDenied, dto.UserId, post.Club.ID)
 	}
 
-	post, err = s.postStorage.DeletePost(ctx, dto.PostId)
+	post, err = s.postStorage.DeletePost(ctx, dto.PostId, dto.UserId)
 	if err != nil {
 		return nil, postservice.HandleError(log, "failed to delete post", err)
 	}
+	// the deleted posts don't count in the tag usage, so nothing changes when they are purged
+	s.tags.TrackUsage(ctx, post.Tags, nil)
+
+	return post, nil
+}
+
+// RestorePost restores the deleted post if its restore window has not passed yet
+func (s Service) RestorePost(ctx context.Context, dto *dtos.ActionRequest) (*domain.Post, error) {
+	const op = "services.post.management.restorePost"
+	log := s.log.With(slog.String("op", op))
+
+	post, err := s.postStorage.GetDeletedPostById(ctx, dto.PostId)
+	if err != nil {
+		return nil, postservice.HandleError(log, "failed to get deleted post by id", err)
+	}
+
+	hasPermission, err := s.clubProvider.HasPermission(ctx, dto.UserId, post.Club.ID, clubv1.Permission_PERMISSION_MANAGE_POSTS)
+	if err != nil {
+		return nil, postservice.HandleError(log, "failed to check permission", err)
+	}
+	if !hasPermission {
+		return nil, fmt.Errorf("%w: user %d does not have permission to manage posts in club %d", postservice.ErrPermissionDenied, dto.UserId, post.Club.ID)
+	}
+
+	if !post.CanBeRestored(time.Now(), s.restoreWindow) {
+		return nil, fmt.Errorf("%w: post %s was deleted at %s", postservice.ErrRestoreWindowExpired, post.ID, post.DeletedAt.Format(time.RFC3339))
+	}
+
+	post, err = s.postStorage.RestorePost(ctx, dto.PostId)
+	if err != nil {
+		return nil, postservice.HandleError(log, "failed to restore post", err)
+	}
+	s.tags.TrackUsage(ctx, nil, post.Tags)
 
 	return post, nil
 }
 
 func (s Service) HidePost(ctx context.Context, dto *dtos.ActionRequest) (*domain.Post, error) {
-	//TODO implement me
-	panic("implement me")
+	const op = "services.post.management.hidePost"
+	log := s.log.With(slog.String("op", op))
+
+	post, err := s.postStorage.GetPostById(ctx, dto.PostId)
+	if err != nil {
+		return nil, postservice.HandleError(log, "failed to get post by id", err)
+	}
+
+	hasPermission, err := s.clubProvider.HasPermission(ctx, dto.UserId, post.Club.ID, clubv1.Permission_PERMISSION_MANAGE_POSTS)
+	if err != nil {
+		return nil, postservice.HandleError(log, "failed to check permission", err)
+	}
+	if !hasPermission {
+		return nil, fmt.Errorf("%w: user %d does not have permission to manage posts in club %d", postservice.ErrPermissionDenied, dto.UserId, post.Club.ID)
+	}
+
+	post, err = s.postStorage.HidePost(ctx, dto.PostId, dto.UserId)
+	if err != nil {
+		return nil, postservice.HandleError(log, "failed to hide post", err)
+	}
+
+	return post, nil
 }
 
 func (s Service) UnhidePost(ctx context.Context, dto *dtos.ActionRequest) (*domain.Post, error) {
-	//TODO implement me
-	panic("implement me")
+	const op = "services.post.management.unhidePost"
+	log := s.log.With(slog.String("op", op))
+
+	post, err := s.postStorage.GetPostById(ctx, dto.PostId)
+	if err != nil {
+		return nil, postservice.HandleError(log, "failed to get post by id", err)
+	}
+
+	hasPermission, err := s.clubProvider.HasPermission(ctx, dto.UserId, post.Club.ID, clubv1.Permission_PERMISSION_MANAGE_POSTS)
+	if err != nil {
+		return nil, postservice.HandleError(log, "failed to check permission", err)
+	}
+	if !hasPermission {
+		return nil, fmt.Errorf("%w: user %d does not have permission to manage posts in club %d", postservice.ErrPermissionDenied, dto.UserId, post.Club.ID)
+	}
+
+	post, err = s.postStorage.UnhidePost(ctx, dto.PostId)
+	if err != nil {
+		return nil, postservice.HandleError(log, "failed to unhide post", err)
+	}
+
+	return post, nil
+}
+
+// PinPost pins the published post on top of the club posts, pinning the already pinned post changes its pin expiration time
+func (s Service) PinPost(ctx context.Context, dto *dtos.PinPost) (*domain.Post, error) {
+	const op = "services.post.management.pinPost"
+	log := s.log.With(slog.String("op", op))
+
+	post, err := s.postStorage.GetPostById(ctx, dto.PostId)
+	if err != nil {
+		return nil, postservice.HandleError(log, "failed to get post by id", err)
+	}
+
+	hasPermission, err := s.clubProvider.HasPermission(ctx, dto.UserId, post.Club.ID, clubv1.Permission_PERMISSION_MANAGE_POSTS)
+	if err != nil {
+		return nil, postservice.HandleError(log, "failed to check permission", err)
+	}
+	if !hasPermission {
+		return nil, fmt.Errorf("%w: user %d does not have permission to manage posts in club %d", postservice.ErrPermissionDenied, dto.UserId, post.Club.ID)
+	}
+
+	if post.Hidden || !post.IsPublished() {
+		return nil, fmt.Errorf("%w: only published posts can be pinned", postservice.ErrInvalidArg)
+	}
+	if !dto.ExpiresAt.IsZero() && !dto.ExpiresAt.After(time.Now()) {
+		return nil, fmt.Errorf("%w: pin expiration time must be in the future", postservice.ErrInvalidArg)
+	}
+
+	// the storage checks the limit with the pin itself, so the concurrent pins can't exceed it
+	pinned, err := s.postStorage.PinPost(ctx, post.Club.ID, dto.PostId, dto.UserId, dto.ExpiresAt, s.maxPinnedPosts)
+	if err != nil {
+		if errors.Is(err, storage.ErrPinLimitReached) {
+			return nil, fmt.Errorf("%w: club %d can have at most %d pinned posts", postservice.ErrPinLimitReached, post.Club.ID, s.maxPinnedPosts)
+		}
+		return nil, postservice.HandleError(log, "failed to pin post", err)
+	}
+
+	return pinned, nil
+}
+
+func (s Service) UnpinPost(ctx context.Context, dto *dtos.ActionRequest) (*domain.Post, error) {
+	const op = "services.post.management.unpinPost"
+	log := s.log.With(slog.String("op", op))
+
+	post, err := s.postStorage.GetPostById(ctx, dto.PostId)
+	if err != nil {
+		return nil, postservice.HandleError(log, "failed to get post by id", err)
+	}
+
+	hasPermission, err := s.clubProvider.HasPermission(ctx, dto.UserId, post.Club.ID, clubv1.Permission_PERMISSION_MANAGE_POSTS)
+	if err != nil {
+		return nil, postservice.HandleError(log, "failed to check permission", err)
+	}
+	if !hasPermission {
+		return nil, fmt.Errorf("%w: user %d does not have permission to manage posts in club %d", postservice.ErrPermissionDenied, dto.UserId, post.Club.ID)
+	}
+
+	post, err = s.postStorage.UnpinPost(ctx, dto.PostId)
+	if err != nil {
+		return nil, postservice.HandleError(log, "failed to unpin post", err)
+	}
+
+	return post, nil
+}
+
+// prepareDescription renders the post markdown description and resolves the mentions in it
+func (s Service) prepareDescription(ctx context.Context, post *domain.Post) error {
+	if err := post.RenderDescription(); err != nil {
+		return err
+	}
+
+	mentions, err := s.mentions.Resolve(ctx, post.Description)
+	if err != nil {
+		return err
+	}
+
+	post.Mentions = mentions
+	return nil
+}
+
+/*
+notifyMentioned notifies the users newly mentioned in the published post, nobody is notified while the post is a draft or scheduled.
+
+	The users mentioned before the publication are notified once the post is published,
+	previous are the mentions of the post before the change and wasPublished is its state before the change.
+*/
+func (s Service) notifyMentioned(ctx context.Context, post *domain.Post, authorId int64, wasPublished bool, previous []domain.Mention) {
+	if !post.IsPublished() {
+		return
+	}
+	if !wasPublished {
+		previous = nil
+	}
+
+	s.mentions.NotifyMentioned(ctx, mentionSource(post, authorId), previous, post.Mentions)
+}
+
+func mentionSource(post *domain.Post, authorId int64) domain.MentionSource {
+	return domain.MentionSource{
+		TargetType: domain.TargetPost,
+		TargetId:   post.ID,
+		Title:      post.Title,
+		ClubId:     post.Club.ID,
+		AuthorId:   authorId,
+	}
+}
+
+// linkEvents checks that the referenced events exist and are not drafts, then links them to the post
+func (s Service) linkEvents(ctx context.Context, post *domain.Post, eventIds []string) error {
+	if err := post.LinkEvents(eventIds); err != nil {
+		return err
+	}
+	if len(post.EventIds) == 0 {
+		return nil
+	}
+
+	events, err := s.eventProvider.GetEventsByIds(ctx, post.EventIds)
+	if err != nil {
+		return err
+	}
+
+	found := make(map[string]bool, len(events))
+	for _, event := range events {
+		if event.Status != domain.EventStatusDraft {
+			found[event.ID] = true
+		}
+	}
+	for _, eventId := range post.EventIds {
+		if !found[eventId] {
+			return fmt.Errorf("%w: event %s not found", domain.ErrInvalidPostEvents, eventId)
+		}
+	}
+
+	return nil
+}
+
+// UnpinExpiredPosts unpins the posts whose pin has expired, it is run periodically by the worker
+func (s Service) UnpinExpiredPosts(ctx context.Context) error {
+	const op = "services.post.management.unpinExpiredPosts"
+	log := s.log.With(slog.String("op", op))
+
+	unpinned, err := s.postStorage.UnpinExpiredPosts(ctx, time.Now())
+	if err != nil {
+		log.Error("failed to unpin expired posts", logger.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if unpinned > 0 {
+		log.Info("expired pins removed", slog.Int64("unpinned", unpinned))
+	}
+
+	return nil
+}
+
+// PurgeDeletedPosts permanently removes the posts whose restore window has passed, it is run periodically by the worker
+func (s Service) PurgeDeletedPosts(ctx context.Context) error {
+	const op = "services.post.management.purgeDeletedPosts"
+	log := s.log.With(slog.String("op", op))
+
+	purged, err := s.postStorage.PurgeDeletedPosts(ctx, time.Now().Add(-s.restoreWindow))
+	if err != nil {
+		log.Error("failed to purge deleted posts", logger.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if purged > 0 {
+		log.Info("deleted posts purged", slog.Int64("purged", purged))
+	}
+
+	return nil
+}
+
+// UpdateScheduledPosts publishes the scheduled posts and expires the outdated ones, it is run periodically by the worker
+func (s Service) UpdateScheduledPosts(ctx context.Context) error {
+	const op = "services.post.management.updateScheduledPosts"
+	log := s.log.With(slog.String("op", op))
+
+	now := time.Now()
+
+	published, err := s.postStorage.PublishScheduledPosts(ctx, now)
+	if err != nil {
+		log.Error("failed to publish scheduled posts", logger.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	// the users mentioned in the scheduled post are notified on its publication, the club is the author
+	for i := range published {
+		s.notifyMentioned(ctx, &published[i], 0, false, nil)
+	}
+
+	expired, err := s.postStorage.ExpirePosts(ctx, now)
+	if err != nil {
+		log.Error("failed to expire posts", logger.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if len(published) > 0 || expired > 0 {
+		log.Info("scheduled posts updated", slog.Int("published", len(published)), slog.Int64("expired", expired))
+	}
+
+	return nil
 }
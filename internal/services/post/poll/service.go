@@ -0,0 +1,101 @@
+package postpoll
+
+import (
+	"context"
+	"fmt"
+	clubv1 "github.com/ARUMANDESU/uniclubs-protos/gen/go/club"
+	"github.com/arumandesu/uniclubs-posts-service/internal/domain"
+	dtos "github.com/arumandesu/uniclubs-posts-service/internal/domain/dto"
+	postservice "github.com/arumandesu/uniclubs-posts-service/internal/services/post"
+	"log/slog"
+	"time"
+)
+
+type Service struct {
+	log          *slog.Logger
+	postProvider PostProvider
+	voteStorage  VoteStorage
+	clubProvider ClubProvider
+}
+
+type PostProvider interface {
+	GetPostById(ctx context.Context, postId string) (*domain.Post, error)
+}
+
+type VoteStorage interface {
+	CreateVote(ctx context.Context, vote *domain.Vote) error
+	GetPollTallies(ctx context.Context, postId string, withVoters bool) ([]domain.PollOptionResult, int64, error)
+}
+
+type ClubProvider interface {
+	HasPermission(ctx context.Context, userId, clubId int64, permission clubv1.Permission) (bool, error)
+	IsClubMember(ctx context.Context, userId, clubId int64) (bool, error)
+}
+
+func New(log *slog.Logger, postProvider PostProvider, voteStorage VoteStorage, clubProvider ClubProvider) *Service {
+	return &Service{
+		log:          log,
+		postProvider: postProvider,
+		voteStorage:  voteStorage,
+		clubProvider: clubProvider,
+	}
+}
+
+// Vote stores the vote of the user and returns the poll results, which are always visible after voting
+func (s Service) Vote(ctx context.Context, dto *dtos.Vote) (*domain.PollResults, error) {
+	const op = "services.post.poll.vote"
+	log := s.log.With(slog.String("op", op))
+
+	post, err := s.postProvider.GetPostById(ctx, dto.PostId)
+	if err != nil {
+		return nil, postservice.HandleError(log, "failed to get post", err)
+	}
+	if post.Hidden || !post.IsPublished() {
+		return nil, postservice.ErrPostNotFound
+	}
+	if post.IsRestricted() {
+		audience, err := postservice.ClubAudience(ctx, s.clubProvider, dto.UserId, post.Club.ID)
+		if err != nil {
+			return nil, postservice.HandleError(log, "failed to check club membership", err)
+		}
+		if !post.IsVisibleTo(audience) {
+			return nil, postservice.ErrPostNotFound
+		}
+	}
+	if !post.IsPoll() {
+		return nil, postservice.ErrPostIsNotPoll
+	}
+
+	now := time.Now()
+	if err = post.Poll.ValidateChoice(dto.OptionIds, now); err != nil {
+		return nil, postservice.HandleError(log, "failed to validate choice", err)
+	}
+
+	if post.Poll.MembersOnly {
+		isMember, err := s.clubProvider.IsClubMember(ctx, dto.UserId, post.Club.ID)
+		if err != nil {
+			return nil, postservice.HandleError(log, "failed to check club membership", err)
+		}
+		if !isMember {
+			return nil, fmt.Errorf("%w: only members of the club %d can vote", postservice.ErrPermissionDenied, post.Club.ID)
+		}
+	}
+
+	err = s.voteStorage.CreateVote(ctx, &domain.Vote{
+		PostId:    post.ID,
+		UserId:    dto.UserId,
+		OptionIds: dto.OptionIds,
+		CreatedAt: now,
+	})
+	if err != nil {
+		return nil, postservice.HandleError(log, "failed to create vote", err)
+	}
+
+	tallies, totalVoters, err := s.voteStorage.GetPollTallies(ctx, post.ID, !post.Poll.Anonymous)
+	if err != nil {
+		return nil, postservice.HandleError(log, "failed to get poll tallies", err)
+	}
+
+	results := post.Poll.Results(tallies, totalVoters)
+	return &results, nil
+}
@@ -1,8 +1,12 @@
 package postservice
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	clubv1 "github.com/ARUMANDESU/uniclubs-protos/gen/go/club"
 	"github.com/arumandesu/uniclubs-posts-service/internal/client/club"
+	"github.com/arumandesu/uniclubs-posts-service/internal/domain"
 	"github.com/arumandesu/uniclubs-posts-service/internal/storage"
 	"github.com/arumandesu/uniclubs-posts-service/pkg/logger"
 	"log/slog"
@@ -15,8 +19,42 @@ var (
 	ErrInvalidArg              = errors.New("invalid argument")
 	ErrInvalidID               = errors.New("invalid id")
 	ErrOptimisticLockingFailed = errors.New("optimistic locking failed")
+	ErrPostIsNotPoll           = errors.New("post is not a poll")
+	ErrPollClosed              = errors.New("poll is closed")
+	ErrAlreadyVoted            = errors.New("user already voted")
+	ErrPinLimitReached         = errors.New("pinned posts limit reached")
+	ErrRevisionNotFound        = errors.New("revision not found")
+	ErrRestoreWindowExpired    = errors.New("restore window expired")
 )
 
+// AudienceProvider resolves the relation of the user to the club
+type AudienceProvider interface {
+	HasPermission(ctx context.Context, userId, clubId int64, permission clubv1.Permission) (bool, error)
+	IsClubMember(ctx context.Context, userId, clubId int64) (bool, error)
+}
+
+// ClubAudience checks if the user can manage the club posts or is the club member, anonymous users are neither
+func ClubAudience(ctx context.Context, clubs AudienceProvider, userId, clubId int64) (domain.PostAudience, error) {
+	if userId == 0 {
+		return domain.PostAudience{}, nil
+	}
+
+	canManage, err := clubs.HasPermission(ctx, userId, clubId, clubv1.Permission_PERMISSION_MANAGE_POSTS)
+	if err != nil {
+		return domain.PostAudience{}, err
+	}
+	if canManage {
+		return domain.PostAudience{IsMember: true, CanManage: true}, nil
+	}
+
+	isMember, err := clubs.IsClubMember(ctx, userId, clubId)
+	if err != nil {
+		return domain.PostAudience{}, err
+	}
+
+	return domain.PostAudience{IsMember: isMember}, nil
+}
+
 func HandleError(log *slog.Logger, msg string, err error) error {
 	switch {
 	case errors.Is(err, club.ErrClubNotFound):
@@ -29,6 +67,17 @@ func HandleError(log *slog.Logger, msg string, err error) error {
 		return ErrInvalidID
 	case errors.Is(err, storage.ErrOptimisticLockingFailed):
 		return ErrOptimisticLockingFailed
+	case errors.Is(err, storage.ErrRevisionNotFound):
+		return ErrRevisionNotFound
+	case errors.Is(err, storage.ErrInvalidCursor):
+		return fmt.Errorf("%w: %w", ErrInvalidArg, err)
+	case errors.Is(err, storage.ErrAlreadyVoted):
+		return ErrAlreadyVoted
+	case errors.Is(err, domain.ErrPollClosed):
+		return ErrPollClosed
+	case errors.Is(err, domain.ErrInvalidPoll), errors.Is(err, domain.ErrInvalidPollChoice), errors.Is(err, domain.ErrInvalidPostEvents),
+		errors.Is(err, domain.ErrTooManyMentions):
+		return fmt.Errorf("%w: %w", ErrInvalidArg, err)
 	default:
 		log.Error(msg, logger.Err(err))
 		return err
@@ -0,0 +1,187 @@
+package reactionservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	clubv1 "github.com/ARUMANDESU/uniclubs-protos/gen/go/club"
+	"github.com/arumandesu/uniclubs-posts-service/internal/client/club"
+	"github.com/arumandesu/uniclubs-posts-service/internal/domain"
+	dtos "github.com/arumandesu/uniclubs-posts-service/internal/domain/dto"
+	postservice "github.com/arumandesu/uniclubs-posts-service/internal/services/post"
+	"github.com/arumandesu/uniclubs-posts-service/internal/storage"
+	"github.com/arumandesu/uniclubs-posts-service/pkg/logger"
+	"log/slog"
+	"time"
+)
+
+var (
+	ErrTargetNotFound    = errors.New("reaction target not found")
+	ErrReactionExists    = errors.New("reaction already exists")
+	ErrReactionNotFound  = errors.New("reaction not found")
+	ErrInvalidArg        = errors.New("invalid argument")
+	ErrInvalidID         = errors.New("invalid id")
+	ErrInvalidReaction   = errors.New("invalid reaction type")
+	ErrInvalidTargetType = errors.New("invalid target type")
+)
+
+type Service struct {
+	log           *slog.Logger
+	storage       ReactionStorage
+	postProvider  PostProvider
+	eventProvider EventProvider
+	clubProvider  ClubProvider
+}
+
+type ReactionStorage interface {
+	AddReaction(ctx context.Context, reaction *domain.Reaction) error
+	RemoveReaction(ctx context.Context, reaction *domain.Reaction) error
+	GetUserReactions(ctx context.Context, targetType domain.TargetType, targetId string, userId int64) ([]domain.ReactionType, error)
+}
+
+type PostProvider interface {
+	GetPostById(ctx context.Context, postId string) (*domain.Post, error)
+}
+
+type EventProvider interface {
+	GetEvent(ctx context.Context, eventId string) (*domain.Event, error)
+}
+
+type ClubProvider interface {
+	HasPermission(ctx context.Context, userId, clubId int64, permission clubv1.Permission) (bool, error)
+	IsClubMember(ctx context.Context, userId, clubId int64) (bool, error)
+}
+
+func New(log *slog.Logger, storage ReactionStorage, postProvider PostProvider, eventProvider EventProvider, clubProvider ClubProvider) *Service {
+	return &Service{
+		log:           log,
+		storage:       storage,
+		postProvider:  postProvider,
+		eventProvider: eventProvider,
+		clubProvider:  clubProvider,
+	}
+}
+
+// AddReaction adds the reaction of the user and returns all reactions of the user on the target
+func (s Service) AddReaction(ctx context.Context, dto *dtos.Reaction) ([]domain.ReactionType, error) {
+	const op = "services.reaction.addReaction"
+	log := s.log.With(slog.String("op", op))
+
+	if err := s.validate(ctx, dto); err != nil {
+		return nil, handleError(log, "failed to validate reaction", err)
+	}
+
+	reaction := &domain.Reaction{
+		TargetType: dto.TargetType,
+		TargetId:   dto.TargetId,
+		UserId:     dto.UserId,
+		Type:       dto.Type,
+		CreatedAt:  time.Now(),
+	}
+
+	err := s.storage.AddReaction(ctx, reaction)
+	if err != nil {
+		return nil, handleError(log, "failed to add reaction", err)
+	}
+
+	reactions, err := s.storage.GetUserReactions(ctx, dto.TargetType, dto.TargetId, dto.UserId)
+	if err != nil {
+		return nil, handleError(log, "failed to get user reactions", err)
+	}
+
+	return reactions, nil
+}
+
+// RemoveReaction removes the reaction of the user and returns the remaining reactions of the user on the target
+func (s Service) RemoveReaction(ctx context.Context, dto *dtos.Reaction) ([]domain.ReactionType, error) {
+	const op = "services.reaction.removeReaction"
+	log := s.log.With(slog.String("op", op))
+
+	if !dto.TargetType.IsValid() {
+		return nil, ErrInvalidTargetType
+	}
+	if !dto.Type.IsValid() {
+		return nil, ErrInvalidReaction
+	}
+
+	err := s.storage.RemoveReaction(ctx, &domain.Reaction{
+		TargetType: dto.TargetType,
+		TargetId:   dto.TargetId,
+		UserId:     dto.UserId,
+		Type:       dto.Type,
+	})
+	if err != nil {
+		return nil, handleError(log, "failed to remove reaction", err)
+	}
+
+	reactions, err := s.storage.GetUserReactions(ctx, dto.TargetType, dto.TargetId, dto.UserId)
+	if err != nil {
+		return nil, handleError(log, "failed to get user reactions", err)
+	}
+
+	return reactions, nil
+}
+
+// validate checks the reaction and that the target is visible to the user:
+// posts must be published and not hidden, draft events are visible only to the organizers
+func (s Service) validate(ctx context.Context, dto *dtos.Reaction) error {
+	if dto.UserId == 0 {
+		return fmt.Errorf("%w: missing user id", ErrInvalidArg)
+	}
+	if !dto.Type.IsValid() {
+		return ErrInvalidReaction
+	}
+
+	switch dto.TargetType {
+	case domain.TargetPost:
+		post, err := s.postProvider.GetPostById(ctx, dto.TargetId)
+		if err != nil {
+			return err
+		}
+		if post.Hidden || !post.IsPublished() {
+			return ErrTargetNotFound
+		}
+		if post.IsRestricted() {
+			audience, err := postservice.ClubAudience(ctx, s.clubProvider, dto.UserId, post.Club.ID)
+			if err != nil {
+				return err
+			}
+			if !post.IsVisibleTo(audience) {
+				return ErrTargetNotFound
+			}
+		}
+	case domain.TargetEvent:
+		event, err := s.eventProvider.GetEvent(ctx, dto.TargetId)
+		if err != nil {
+			return err
+		}
+		if event.Status == domain.EventStatusDraft && !event.IsOrganizer(dto.UserId) {
+			return ErrTargetNotFound
+		}
+	default:
+		return ErrInvalidTargetType
+	}
+
+	return nil
+}
+
+func handleError(log *slog.Logger, msg string, err error) error {
+	switch {
+	case errors.Is(err, storage.ErrNotFound), errors.Is(err, storage.ErrEventNotFound), errors.Is(err, club.ErrClubNotFound),
+		errors.Is(err, ErrTargetNotFound):
+		return ErrTargetNotFound
+	case errors.Is(err, storage.ErrInvalidID):
+		return ErrInvalidID
+	case errors.Is(err, storage.ErrReactionExists):
+		return ErrReactionExists
+	case errors.Is(err, storage.ErrReactionNotFound):
+		return ErrReactionNotFound
+	case errors.Is(err, club.ErrInvalidArg):
+		return ErrInvalidArg
+	case errors.Is(err, ErrInvalidArg), errors.Is(err, ErrInvalidReaction), errors.Is(err, ErrInvalidTargetType):
+		return err
+	default:
+		log.Error(msg, logger.Err(err))
+		return err
+	}
+}
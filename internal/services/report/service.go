@@ -0,0 +1,259 @@
+package reportservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	clubv1 "github.com/ARUMANDESU/uniclubs-protos/gen/go/club"
+	"github.com/arumandesu/uniclubs-posts-service/internal/client/club"
+	"github.com/arumandesu/uniclubs-posts-service/internal/domain"
+	dtos "github.com/arumandesu/uniclubs-posts-service/internal/domain/dto"
+	eventservice "github.com/arumandesu/uniclubs-posts-service/internal/services/event"
+	postservice "github.com/arumandesu/uniclubs-posts-service/internal/services/post"
+	"github.com/arumandesu/uniclubs-posts-service/internal/storage"
+	"github.com/arumandesu/uniclubs-posts-service/pkg/logger"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"log/slog"
+	"time"
+)
+
+var (
+	ErrTargetNotFound   = errors.New("report target not found")
+	ErrReportExists     = errors.New("report already exists")
+	ErrReportNotFound   = errors.New("report not found")
+	ErrReportClosed     = errors.New("report is closed")
+	ErrPermissionDenied = errors.New("permission denied")
+	ErrInvalidArg       = errors.New("invalid argument")
+	ErrInvalidID        = errors.New("invalid id")
+)
+
+type Service struct {
+	log           *slog.Logger
+	storage       ReportStorage
+	postProvider  PostProvider
+	eventProvider EventProvider
+	eventDeleter  EventDeleter
+	clubProvider  ClubProvider
+}
+
+type ReportStorage interface {
+	CreateReport(ctx context.Context, report *domain.Report) (*domain.Report, error)
+	GetReportById(ctx context.Context, reportId string) (*domain.Report, error)
+	ListReports(ctx context.Context, dto *dtos.ListReports) ([]domain.Report, *domain.PaginationMetadata, error)
+	ListModerationQueue(ctx context.Context, dto *dtos.ListModerationQueue) ([]domain.ModerationQueueItem, *domain.PaginationMetadata, error)
+	CloseReports(ctx context.Context, report *domain.Report) (int64, error)
+}
+
+type PostProvider interface {
+	GetPostById(ctx context.Context, postId string) (*domain.Post, error)
+	HidePost(ctx context.Context, postId string, userId int64) (*domain.Post, error)
+}
+
+type EventProvider interface {
+	GetEvent(ctx context.Context, eventId string) (*domain.Event, error)
+}
+
+// EventDeleter deletes the event together with its participants, bans and invites
+type EventDeleter interface {
+	DeleteEvent(ctx context.Context, dto *dtos.DeleteEvent) (*domain.Event, error)
+}
+
+type ClubProvider interface {
+	HasPermission(ctx context.Context, userId, clubId int64, permission clubv1.Permission) (bool, error)
+	IsClubMember(ctx context.Context, userId, clubId int64) (bool, error)
+}
+
+func New(
+	log *slog.Logger,
+	storage ReportStorage,
+	postProvider PostProvider,
+	eventProvider EventProvider,
+	eventDeleter EventDeleter,
+	clubProvider ClubProvider,
+) *Service {
+	return &Service{
+		log:           log,
+		storage:       storage,
+		postProvider:  postProvider,
+		eventProvider: eventProvider,
+		eventDeleter:  eventDeleter,
+		clubProvider:  clubProvider,
+	}
+}
+
+// Report flags the post or the event, a user can have only one open report per target
+func (s Service) Report(ctx context.Context, dto *dtos.CreateReport) (*domain.Report, error) {
+	const op = "services.report.report"
+	log := s.log.With(slog.String("op", op))
+
+	if dto.UserId == 0 {
+		return nil, fmt.Errorf("%w: missing user id", ErrInvalidArg)
+	}
+	if err := domain.ValidateReport(dto.Reason, dto.Text); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidArg, err)
+	}
+	if err := s.checkTargetVisible(ctx, dto.TargetType, dto.TargetId, dto.UserId); err != nil {
+		return nil, handleError(log, "failed to get report target", err)
+	}
+
+	report, err := s.storage.CreateReport(ctx, &domain.Report{
+		ID:         primitive.NewObjectID().Hex(),
+		TargetType: dto.TargetType,
+		TargetId:   dto.TargetId,
+		ReporterId: dto.UserId,
+		Reason:     dto.Reason,
+		Text:       dto.Text,
+		Status:     domain.ReportStatusOpen,
+		CreatedAt:  time.Now(),
+	})
+	if err != nil {
+		return nil, handleError(log, "failed to create report", err)
+	}
+
+	return report, nil
+}
+
+// ListModerationQueue returns the reported targets sorted by the open reports count, it is available to the admins only
+func (s Service) ListModerationQueue(ctx context.Context, dto *dtos.ListModerationQueue) ([]domain.ModerationQueueItem, *domain.PaginationMetadata, error) {
+	const op = "services.report.listModerationQueue"
+	log := s.log.With(slog.String("op", op))
+
+	if !dto.IsAdmin {
+		return nil, nil, ErrPermissionDenied
+	}
+	if dto.TargetType != "" && !dto.TargetType.IsValid() {
+		return nil, nil, fmt.Errorf("%w: unknown target type %q", ErrInvalidArg, dto.TargetType)
+	}
+
+	items, metadata, err := s.storage.ListModerationQueue(ctx, dto)
+	if err != nil {
+		return nil, nil, handleError(log, "failed to list moderation queue", err)
+	}
+
+	return items, metadata, nil
+}
+
+// ListReports returns the reports of the target including the closed ones, it is available to the admins only
+func (s Service) ListReports(ctx context.Context, dto *dtos.ListReports) ([]domain.Report, *domain.PaginationMetadata, error) {
+	const op = "services.report.listReports"
+	log := s.log.With(slog.String("op", op))
+
+	if !dto.IsAdmin {
+		return nil, nil, ErrPermissionDenied
+	}
+	if !dto.TargetType.IsValid() {
+		return nil, nil, fmt.Errorf("%w: unknown target type %q", ErrInvalidArg, dto.TargetType)
+	}
+
+	reports, metadata, err := s.storage.ListReports(ctx, dto)
+	if err != nil {
+		return nil, nil, handleError(log, "failed to list reports", err)
+	}
+
+	return reports, metadata, nil
+}
+
+// ModerateReport applies the admin action to the reported target and records it against
+// the report and all other open reports of the same target, the target which is already deleted is treated as actioned
+func (s Service) ModerateReport(ctx context.Context, dto *dtos.ModerateReport) (*domain.Report, error) {
+	const op = "services.report.moderateReport"
+	log := s.log.With(slog.String("op", op))
+
+	if !dto.IsAdmin {
+		return nil, ErrPermissionDenied
+	}
+
+	report, err := s.storage.GetReportById(ctx, dto.ReportId)
+	if err != nil {
+		return nil, handleError(log, "failed to get report", err)
+	}
+
+	if err = report.Close(dto.Action, dto.UserId, dto.Note, time.Now()); err != nil {
+		return nil, handleError(log, "failed to close report", err)
+	}
+
+	switch report.Action {
+	case domain.ModerationHidePost:
+		_, err = s.postProvider.HidePost(ctx, report.TargetId, dto.UserId)
+		if err != nil && !isTargetDeleted(err) {
+			return nil, handleError(log, "failed to hide post", err)
+		}
+	case domain.ModerationDeleteEvent:
+		_, err = s.eventDeleter.DeleteEvent(ctx, &dtos.DeleteEvent{EventId: report.TargetId, UserId: dto.UserId, IsAdmin: true})
+		if err != nil && !isTargetDeleted(err) {
+			return nil, handleError(log, "failed to delete event", err)
+		}
+	}
+
+	closed, err := s.storage.CloseReports(ctx, report)
+	if err != nil {
+		return nil, handleError(log, "failed to close reports", err)
+	}
+	log.Info("reports closed", slog.String("target_id", report.TargetId), slog.String("action", report.Action.String()), slog.Int64("count", closed))
+
+	return report, nil
+}
+
+// isTargetDeleted reports if the moderation action failed because the target has already been deleted or purged
+func isTargetDeleted(err error) bool {
+	return errors.Is(err, storage.ErrNotFound) || errors.Is(err, storage.ErrEventNotFound) || errors.Is(err, eventservice.ErrEventNotFound)
+}
+
+// checkTargetVisible checks that the user can see the target they report: the hidden, unpublished
+// and members only posts follow the post visibility rules, draft events are visible only to the organizers
+func (s Service) checkTargetVisible(ctx context.Context, targetType domain.TargetType, targetId string, userId int64) error {
+	switch targetType {
+	case domain.TargetPost:
+		post, err := s.postProvider.GetPostById(ctx, targetId)
+		if err != nil {
+			return err
+		}
+		if !post.NeedsAudience() {
+			return nil
+		}
+		audience, err := postservice.ClubAudience(ctx, s.clubProvider, userId, post.Club.ID)
+		if err != nil {
+			return err
+		}
+		if !post.IsReadableBy(audience) {
+			return ErrTargetNotFound
+		}
+	case domain.TargetEvent:
+		event, err := s.eventProvider.GetEvent(ctx, targetId)
+		if err != nil {
+			return err
+		}
+		if !event.IsVisibleTo(userId) {
+			return ErrTargetNotFound
+		}
+	default:
+		return fmt.Errorf("%w: unknown target type %q", ErrInvalidArg, targetType)
+	}
+
+	return nil
+}
+
+func handleError(log *slog.Logger, msg string, err error) error {
+	switch {
+	case errors.Is(err, storage.ErrNotFound), errors.Is(err, storage.ErrEventNotFound),
+		errors.Is(err, eventservice.ErrEventNotFound), errors.Is(err, club.ErrClubNotFound), errors.Is(err, ErrTargetNotFound):
+		return ErrTargetNotFound
+	case errors.Is(err, storage.ErrInvalidID), errors.Is(err, eventservice.ErrInvalidID):
+		return ErrInvalidID
+	case errors.Is(err, storage.ErrReportExists):
+		return ErrReportExists
+	case errors.Is(err, storage.ErrReportNotFound):
+		return ErrReportNotFound
+	case errors.Is(err, domain.ErrReportClosed):
+		return ErrReportClosed
+	case errors.Is(err, domain.ErrInvalidModerationAction):
+		return fmt.Errorf("%w: %w", ErrInvalidArg, err)
+	case errors.Is(err, club.ErrInvalidArg):
+		return ErrInvalidArg
+	case errors.Is(err, ErrInvalidArg):
+		return err
+	default:
+		log.Error(msg, logger.Err(err))
+		return err
+	}
+}
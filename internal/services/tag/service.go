@@ -0,0 +1,168 @@
+package tagservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/arumandesu/uniclubs-posts-service/internal/domain"
+	dtos "github.com/arumandesu/uniclubs-posts-service/internal/domain/dto"
+	"github.com/arumandesu/uniclubs-posts-service/internal/storage"
+	"github.com/arumandesu/uniclubs-posts-service/pkg/logger"
+	"log/slog"
+	"time"
+)
+
+var (
+	ErrTagNotFound      = errors.New("tag not found")
+	ErrPermissionDenied = errors.New("permission denied")
+	ErrInvalidArg       = errors.New("invalid argument")
+)
+
+type Service struct {
+	log     *slog.Logger
+	storage TagStorage
+}
+
+type TagStorage interface {
+	GetTag(ctx context.Context, slug string) (*domain.Tag, error)
+	ResolveTagAliases(ctx context.Context, slugs []string) (map[string]string, error)
+	IncrementTagUsage(ctx context.Context, delta map[string]int64, now time.Time) error
+	SuggestTags(ctx context.Context, prefix string, limit int64) ([]domain.Tag, error)
+	MergeTags(ctx context.Context, source, target string, now time.Time) (*domain.Tag, error)
+	GetTagAliases(ctx context.Context) (map[string]string, error)
+	NormalizeStoredTags(ctx context.Context, canonical map[string]string, now time.Time) (int64, error)
+	RecountTagUsage(ctx context.Context, now time.Time) error
+}
+
+func New(log *slog.Logger, storage TagStorage) *Service {
+	return &Service{
+		log:     log,
+		storage: storage,
+	}
+}
+
+// Resolve normalizes the tags and replaces the merged ones with their canonical tags
+func (s Service) Resolve(ctx context.Context, tags []string) ([]string, error) {
+	const op = "services.tag.resolve"
+	log := s.log.With(slog.String("op", op))
+
+	slugs := domain.NormalizeTags(tags)
+	if len(slugs) == 0 {
+		return slugs, nil
+	}
+
+	canonical, err := s.storage.ResolveTagAliases(ctx, slugs)
+	if err != nil {
+		return nil, handleError(log, "failed to resolve tag aliases", err)
+	}
+
+	return domain.CanonicalTags(slugs, canonical), nil
+}
+
+// TrackUsage updates the usage counters after the tags of an event or a post were changed,
+// the counters are not critical, so the failure is only logged
+func (s Service) TrackUsage(ctx context.Context, previous, current []string) {
+	const op = "services.tag.trackUsage"
+	log := s.log.With(slog.String("op", op))
+
+	delta := domain.TagUsageDelta(previous, current)
+	if len(delta) == 0 {
+		return
+	}
+
+	if err := s.storage.IncrementTagUsage(ctx, delta, time.Now()); err != nil {
+		log.Warn("failed to update tag usage", logger.Err(err))
+	}
+}
+
+// SuggestTags returns the most used tags starting with the prefix for the autocomplete
+func (s Service) SuggestTags(ctx context.Context, prefix string) ([]domain.Tag, error) {
+	const op = "services.tag.suggestTags"
+	log := s.log.With(slog.String("op", op))
+
+	slug := domain.NormalizeTag(prefix)
+	if slug == "" {
+		return nil, fmt.Errorf("%w: empty prefix", ErrInvalidArg)
+	}
+
+	tags, err := s.storage.SuggestTags(ctx, slug, domain.MaxTagSuggestions)
+	if err != nil {
+		return nil, handleError(log, "failed to suggest tags", err)
+	}
+
+	return tags, nil
+}
+
+// MergeTags makes the source tag an alias of the target one and retags the events and posts, it is available to the admins only
+func (s Service) MergeTags(ctx context.Context, dto *dtos.MergeTags) (*domain.Tag, error) {
+	const op = "services.tag.mergeTags"
+	log := s.log.With(slog.String("op", op))
+
+	if !dto.IsAdmin {
+		return nil, ErrPermissionDenied
+	}
+
+	tags, err := s.Resolve(ctx, []string{dto.Target})
+	if err != nil {
+		return nil, err
+	}
+	source := domain.NormalizeTag(dto.Source)
+	if source == "" || len(tags) == 0 {
+		return nil, fmt.Errorf("%w: source and target tags are required", ErrInvalidArg)
+	}
+	target := tags[0]
+	if source == target {
+		return nil, fmt.Errorf("%w: tag %q is already merged into %q", ErrInvalidArg, dto.Source, target)
+	}
+
+	tag, err := s.storage.MergeTags(ctx, source, target, time.Now())
+	if err != nil {
+		return nil, handleError(log, "failed to merge tags", err)
+	}
+	log.Info("tags merged", slog.String("source", source), slog.String("target", target), slog.Int64("user_id", dto.UserId))
+
+	return tag, nil
+}
+
+/*
+BackfillTags normalizes the tags of the events and posts stored before the tags were normalized, replaces the merged tags
+left in the documents and recounts the usage of the tags, it is run by the worker when the service starts.
+
+	It is safe to run it again: the normalized documents are not changed.
+*/
+func (s Service) BackfillTags(ctx context.Context) error {
+	const op = "services.tag.backfillTags"
+	log := s.log.With(slog.String("op", op))
+
+	now := time.Now()
+
+	canonical, err := s.storage.GetTagAliases(ctx)
+	if err != nil {
+		log.Error("failed to get tag aliases", logger.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	changed, err := s.storage.NormalizeStoredTags(ctx, canonical, now)
+	if err != nil {
+		log.Error("failed to normalize stored tags", logger.Err(err), slog.Int64("changed", changed))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = s.storage.RecountTagUsage(ctx, now); err != nil {
+		log.Error("failed to recount tag usage", logger.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("tags backfilled", slog.Int64("changed", changed))
+	return nil
+}
+
+func handleError(log *slog.Logger, msg string, err error) error {
+	switch {
+	case errors.Is(err, storage.ErrTagNotFound):
+		return ErrTagNotFound
+	default:
+		log.Error(msg, logger.Err(err))
+		return err
+	}
+}
@@ -0,0 +1,151 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/arumandesu/uniclubs-posts-service/internal/domain"
+	dtos "github.com/arumandesu/uniclubs-posts-service/internal/domain/dto"
+	"github.com/arumandesu/uniclubs-posts-service/internal/storage"
+	"github.com/arumandesu/uniclubs-posts-service/internal/storage/mongodb/dao"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"time"
+)
+
+func (s *Storage) CreateComment(ctx context.Context, comment *domain.Comment) (*domain.Comment, error) {
+	const op = "storage.mongodb.comment.createComment"
+
+	daoComment, err := dao.CommentFromDomain(comment)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, storage.ErrInvalidID)
+	}
+
+	_, err = s.commentsCollection.InsertOne(ctx, daoComment)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if daoComment.ParentId != nil {
+		_, err = s.commentsCollection.UpdateOne(ctx,
+			bson.M{"_id": *daoComment.ParentId},
+			bson.M{"$inc": bson.M{"replies_count": 1}},
+		)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	return dao.CommentToDomain(daoComment), nil
+}
+
+func (s *Storage) GetCommentById(ctx context.Context, commentId string) (*domain.Comment, error) {
+	const op = "storage.mongodb.comment.getCommentById"
+
+	objectID, err := primitive.ObjectIDFromHex(commentId)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, storage.ErrInvalidID)
+	}
+
+	var comment dao.Comment
+	err = s.commentsCollection.FindOne(ctx, bson.M{"_id": objectID}).Decode(&comment)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, fmt.Errorf("%s: %w", op, storage.ErrCommentNotFound)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return dao.CommentToDomain(&comment), nil
+}
+
+func (s *Storage) UpdateComment(ctx context.Context, comment *domain.Comment) (*domain.Comment, error) {
+	const op = "storage.mongodb.comment.updateComment"
+
+	daoComment, err := dao.CommentFromDomain(comment)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, storage.ErrInvalidID)
+	}
+
+	lastUpdated := daoComment.UpdatedAt
+	daoComment.UpdatedAt = time.Now()
+
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	filter := bson.M{"_id": daoComment.ID, "updated_at": lastUpdated}
+	update := bson.M{"$set": daoComment}
+	if !daoComment.Hidden {
+		// hidden_by is omitted from $set when empty, so it has to be removed explicitly on unhide
+		update["$unset"] = bson.M{"hidden_by": ""}
+	}
+
+	err = s.commentsCollection.FindOneAndUpdate(ctx, filter, update, opts).Decode(daoComment)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, fmt.Errorf("%s: %w", op, storage.ErrOptimisticLockingFailed)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return dao.CommentToDomain(daoComment), nil
+}
+
+func (s *Storage) ListComments(ctx context.Context, dto *dtos.ListComments) ([]domain.Comment, *domain.PaginationMetadata, error) {
+	const op = "storage.mongodb.comment.listComments"
+
+	filter := bson.M{
+		"target_type": dto.TargetType.String(),
+		"target_id":   dto.TargetId,
+		"parent_id":   nil,
+	}
+	if dto.ParentId != "" {
+		parentId, err := primitive.ObjectIDFromHex(dto.ParentId)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%s: %w", op, storage.ErrInvalidID)
+		}
+		filter["parent_id"] = parentId
+	}
+	if !dto.IncludeHidden {
+		filter["hidden"] = false
+	}
+
+	sortOrder := 1
+	if dto.Filter.SortOrder == domain.SortOrderDesc {
+		sortOrder = -1
+	}
+	sort := bson.D{{Key: "created_at", Value: sortOrder}, {Key: "_id", Value: sortOrder}}
+
+	page, err := findPage(ctx, s.commentsCollection, filter, sort, dto.Filter, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if page.NoMatches {
+		return nil, &domain.PaginationMetadata{}, nil
+	}
+
+	comments, err := decodeDocuments[dao.Comment](page.Documents)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return dao.CommentsToDomain(comments), &page.Metadata, nil
+}
+
+func (s *Storage) updateUserInCommentsCollection(ctx context.Context, user *domain.User) error {
+	const op = "storage.mongodb.updateUserInCommentsCollection"
+
+	_, err := s.commentsCollection.UpdateMany(ctx, bson.M{"user._id": user.ID}, bson.M{
+		"$set": bson.M{
+			"user.first_name": user.FirstName,
+			"user.last_name":  user.LastName,
+			"user.barcode":    user.Barcode,
+			"user.avatar_url": user.AvatarURL,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,255 @@
+package mongodb
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"github.com/arumandesu/uniclubs-posts-service/internal/domain"
+	"github.com/arumandesu/uniclubs-posts-service/internal/storage"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"slices"
+	"strings"
+)
+
+// pageCursor is the position after the last document of the page, it keeps the sort keys
+// so the cursor of one listing can not be used with another sort
+type pageCursor struct {
+	Keys   []string `bson:"k"`
+	Values bson.A   `bson:"v"`
+}
+
+// documentsPage is the page of the raw documents, NoMatches is true when nothing matches the filter at all
+type documentsPage struct {
+	Documents []bson.Raw
+	Metadata  domain.PaginationMetadata
+	NoMatches bool
+}
+
+/*
+findPage returns the page of the documents by the page number or, when the cursor is set, the documents after the cursor.
+
+	_id is added to the sort as a tie-breaker, so the keyset of the sort is unique.
+	The next cursor is returned when there are more documents, it is not available for the text score sort.
+	The total records are not counted when SkipCount is set.
+*/
+func findPage(ctx context.Context, collection *mongo.Collection, filter bson.M, sort bson.D, page domain.BaseFilter, opts *options.FindOptions) (*documentsPage, error) {
+	const op = "storage.mongodb.cursor.findPage"
+
+	sort = withIdTieBreaker(sort)
+	keysetSort := isKeysetSort(sort)
+
+	var result documentsPage
+	if !page.SkipCount {
+		totalRecords, err := collection.CountDocuments(ctx, filter)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		if totalRecords == 0 {
+			return &documentsPage{NoMatches: true}, nil
+		}
+		result.Metadata = domain.CalculatePaginationMetadata(int32(totalRecords), page.Page, page.PageSize)
+	}
+
+	if opts == nil {
+		opts = options.Find()
+	}
+	opts.SetSort(sort)
+	if page.Limit() > 0 {
+		// one more document is fetched to know whether there is the next page
+		opts.SetLimit(int64(page.Limit()) + 1)
+	}
+
+	pageFilter := filter
+	if page.Cursor != "" {
+		if !keysetSort {
+			return nil, fmt.Errorf("%s: %w: cursor can not be used with the relevance sort", op, storage.ErrInvalidCursor)
+		}
+		keyset, err := decodeCursor(page.Cursor, sort)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		pageFilter = withCondition(filter, keyset)
+	} else {
+		opts.SetSkip(int64(page.Offset()))
+	}
+
+	cursor, err := collection.Find(ctx, pageFilter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer cursor.Close(ctx)
+
+	if err = cursor.All(ctx, &result.Documents); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = result.trim(sort, page, keysetSort); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &result, nil
+}
+
+// trim drops the extra document fetched to know whether there is the next page and sets the next cursor
+func (p *documentsPage) trim(sort bson.D, page domain.BaseFilter, keysetSort bool) error {
+	if page.Limit() > 0 && len(p.Documents) > int(page.Limit()) {
+		p.Documents = p.Documents[:page.Limit()]
+		if keysetSort {
+			nextCursor, err := encodeCursor(sort, p.Documents[len(p.Documents)-1])
+			if err != nil {
+				return err
+			}
+			p.Metadata.NextCursor = nextCursor
+		}
+	}
+	if page.SkipCount {
+		p.Metadata.CurrentPage = page.Page
+		p.Metadata.PageSize = page.PageSize
+		p.NoMatches = len(p.Documents) == 0 && page.Cursor == "" && page.Offset() <= 0
+	}
+
+	return nil
+}
+
+// decodeDocuments decodes the raw documents of the page into the dao documents
+func decodeDocuments[T any](documents []bson.Raw) ([]T, error) {
+	result := make([]T, len(documents))
+	for i, document := range documents {
+		if err := bson.Unmarshal(document, &result[i]); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func encodeCursor(sort bson.D, last bson.Raw) (string, error) {
+	c := pageCursor{Keys: make([]string, 0, len(sort)), Values: make(bson.A, 0, len(sort))}
+	for _, e := range sort {
+		c.Keys = append(c.Keys, e.Key)
+
+		value, err := last.LookupErr(strings.Split(e.Key, ".")...)
+		if err != nil {
+			// the missing field is sorted as null
+			c.Values = append(c.Values, nil)
+			continue
+		}
+		c.Values = append(c.Values, value)
+	}
+
+	data, err := bson.Marshal(c)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// decodeCursor returns the condition which matches the documents after the cursor in the sort order
+func decodeCursor(cursor string, sort bson.D) (bson.M, error) {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, storage.ErrInvalidCursor
+	}
+
+	var c pageCursor
+	if err = bson.Unmarshal(data, &c); err != nil {
+		return nil, storage.ErrInvalidCursor
+	}
+	if len(c.Values) != len(sort) || !slices.Equal(c.Keys, sortKeys(sort)) {
+		return nil, fmt.Errorf("%w: cursor was created for another sort", storage.ErrInvalidCursor)
+	}
+
+	return keysetCondition(sort, c.Values), nil
+}
+
+/*
+keysetCondition builds the condition of the documents after the values in the sort order:
+the first key is after its value, or it is equal and the next key is after its value, and so on.
+
+	The missing fields are sorted as null which is before any value, so they are handled separately.
+*/
+func keysetCondition(sort bson.D, values bson.A) bson.M {
+	or := make(bson.A, 0, len(sort))
+	for i, e := range sort {
+		after, ok := afterValue(e.Key, values[i], sortDirection(e.Value))
+		if ok {
+			clause := bson.M{}
+			for j := 0; j < i; j++ {
+				clause[sort[j].Key] = values[j]
+			}
+			for k, v := range after {
+				clause[k] = v
+			}
+			or = append(or, clause)
+		}
+	}
+
+	if len(or) == 0 {
+		// nothing can be after the last document
+		return bson.M{"_id": bson.M{"$exists": false}}
+	}
+	return bson.M{"$or": or}
+}
+
+// afterValue returns the condition of the field values after the value, false if there are no such values
+func afterValue(key string, value any, direction int) (bson.M, bool) {
+	if direction >= 0 {
+		if value == nil {
+			return bson.M{key: bson.M{"$ne": nil}}, true
+		}
+		return bson.M{key: bson.M{"$gt": value}}, true
+	}
+
+	if value == nil {
+		return nil, false
+	}
+	return bson.M{"$or": bson.A{
+		bson.M{key: bson.M{"$lt": value}},
+		bson.M{key: nil},
+	}}, true
+}
+
+func withCondition(filter bson.M, condition bson.M) bson.M {
+	result := make(bson.M, len(filter)+1)
+	for k, v := range filter {
+		result[k] = v
+	}
+
+	and, _ := result["$and"].([]bson.M)
+	result["$and"] = append(slices.Clone(and), condition)
+	return result
+}
+
+func withIdTieBreaker(sort bson.D) bson.D {
+	if len(sort) > 0 && sort[len(sort)-1].Key == "_id" {
+		return sort
+	}
+	return append(slices.Clone(sort), bson.E{Key: "_id", Value: 1})
+}
+
+// isKeysetSort reports whether the sort has only the document fields, the text score can not be used in the cursor
+func isKeysetSort(sort bson.D) bool {
+	for _, e := range sort {
+		if _, ok := e.Value.(int); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+func sortKeys(sort bson.D) []string {
+	keys := make([]string, 0, len(sort))
+	for _, e := range sort {
+		keys = append(keys, e.Key)
+	}
+	return keys
+}
+
+func sortDirection(value any) int {
+	if direction, ok := value.(int); ok {
+		return direction
+	}
+	return 1
+}
@@ -0,0 +1,71 @@
+package mongodb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/arumandesu/uniclubs-posts-service/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestCursorRoundTrip(t *testing.T) {
+	id := primitive.NewObjectID()
+	createdAt := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	last, err := bson.Marshal(bson.M{"_id": id, "created_at": createdAt, "title": "Title"})
+	require.NoError(t, err)
+
+	sort := bson.D{{Key: "created_at", Value: -1}, {Key: "_id", Value: -1}}
+	cursor, err := encodeCursor(sort, last)
+	require.NoError(t, err)
+
+	condition, err := decodeCursor(cursor, sort)
+	require.NoError(t, err)
+	assert.Equal(t, bson.M{"$or": bson.A{
+		bson.M{"$or": bson.A{
+			bson.M{"created_at": bson.M{"$lt": primitive.NewDateTimeFromTime(createdAt)}},
+			bson.M{"created_at": nil},
+		}},
+		bson.M{"created_at": primitive.NewDateTimeFromTime(createdAt), "$or": bson.A{
+			bson.M{"_id": bson.M{"$lt": id}},
+			bson.M{"_id": nil},
+		}},
+	}}, condition)
+
+	_, err = decodeCursor(cursor, bson.D{{Key: "title", Value: 1}, {Key: "_id", Value: 1}})
+	assert.ErrorIs(t, err, storage.ErrInvalidCursor, "cursor of another sort")
+
+	_, err = decodeCursor("not a cursor!", sort)
+	assert.ErrorIs(t, err, storage.ErrInvalidCursor)
+}
+
+func TestKeysetCondition_MissingValues(t *testing.T) {
+	id := primitive.NewObjectID()
+	sort := bson.D{{Key: "reactions_count", Value: 1}, {Key: "_id", Value: 1}}
+
+	assert.Equal(t, bson.M{"$or": bson.A{
+		bson.M{"reactions_count": bson.M{"$ne": nil}},
+		bson.M{"reactions_count": nil, "_id": bson.M{"$gt": id}},
+	}}, keysetCondition(sort, bson.A{nil, id}), "missing values are sorted before any value")
+
+	sort = bson.D{{Key: "reactions_count", Value: -1}, {Key: "_id", Value: -1}}
+	assert.Equal(t, bson.M{"$or": bson.A{
+		bson.M{"reactions_count": nil, "$or": bson.A{
+			bson.M{"_id": bson.M{"$lt": id}},
+			bson.M{"_id": nil},
+		}},
+	}}, keysetCondition(sort, bson.A{nil, id}), "nothing is after the missing value in the descending order")
+}
+
+func TestSortHelpers(t *testing.T) {
+	assert.Equal(t, bson.D{{Key: "title", Value: 1}, {Key: "_id", Value: 1}}, withIdTieBreaker(bson.D{{Key: "title", Value: 1}}))
+	assert.Equal(t, bson.D{{Key: "_id", Value: -1}}, withIdTieBreaker(bson.D{{Key: "_id", Value: -1}}))
+
+	assert.True(t, isKeysetSort(bson.D{{Key: "created_at", Value: 1}, {Key: "_id", Value: 1}}))
+	assert.False(t, isKeysetSort(bson.D{{Key: "score", Value: bson.M{"$meta": "textScore"}}, {Key: "_id", Value: -1}}))
+
+	filter := withCondition(bson.M{"$and": []bson.M{{"a": 1}}}, bson.M{"b": 2})
+	assert.Equal(t, bson.M{"$and": []bson.M{{"a": 1}, {"b": 2}}}, filter)
+}
@@ -0,0 +1,93 @@
+package dao
+
+import (
+	"github.com/arumandesu/uniclubs-posts-service/internal/domain"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"time"
+)
+
+type Comment struct {
+	ID           primitive.ObjectID  `bson:"_id"`
+	TargetType   string              `bson:"target_type"`
+	TargetId     string              `bson:"target_id"`
+	ParentId     *primitive.ObjectID `bson:"parent_id"`
+	User         User                `bson:"user"`
+	Body         string              `bson:"body"`
+	RepliesCount int64               `bson:"replies_count,omitempty"`
+	IsEdited     bool                `bson:"is_edited"`
+	IsDeleted    bool                `bson:"is_deleted"`
+	DeletedBy    int64               `bson:"deleted_by,omitempty"`
+	Hidden       bool                `bson:"hidden"`
+	HiddenBy     int64               `bson:"hidden_by,omitempty"`
+	CreatedAt    time.Time           `bson:"created_at"`
+	UpdatedAt    time.Time           `bson:"updated_at"`
+	DeletedAt    time.Time           `bson:"deleted_at,omitempty"`
+}
+
+// CommentFromDomain converts the domain comment to the dao one.
+// RepliesCount is not copied, it is changed only atomically when the replies are created.
+func CommentFromDomain(c *domain.Comment) (*Comment, error) {
+	objectID, err := primitive.ObjectIDFromHex(c.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	var parentId *primitive.ObjectID
+	if c.ParentId != "" {
+		id, err := primitive.ObjectIDFromHex(c.ParentId)
+		if err != nil {
+			return nil, err
+		}
+		parentId = &id
+	}
+
+	return &Comment{
+		ID:         objectID,
+		TargetType: c.TargetType.String(),
+		TargetId:   c.TargetId,
+		ParentId:   parentId,
+		User:       UserFromDomainUser(c.User),
+		Body:       c.Body,
+		IsEdited:   c.IsEdited,
+		IsDeleted:  c.IsDeleted,
+		DeletedBy:  c.DeletedBy,
+		Hidden:     c.Hidden,
+		HiddenBy:   c.HiddenBy,
+		CreatedAt:  c.CreatedAt,
+		UpdatedAt:  c.UpdatedAt,
+		DeletedAt:  c.DeletedAt,
+	}, nil
+}
+
+func CommentToDomain(c *Comment) *domain.Comment {
+	var parentId string
+	if c.ParentId != nil {
+		parentId = c.ParentId.Hex()
+	}
+
+	return &domain.Comment{
+		ID:           c.ID.Hex(),
+		TargetType:   domain.TargetType(c.TargetType),
+		TargetId:     c.TargetId,
+		ParentId:     parentId,
+		User:         ToDomainUser(c.User),
+		Body:         c.Body,
+		RepliesCount: c.RepliesCount,
+		IsEdited:     c.IsEdited,
+		IsDeleted:    c.IsDeleted,
+		DeletedBy:    c.DeletedBy,
+		Hidden:       c.Hidden,
+		HiddenBy:     c.HiddenBy,
+		CreatedAt:    c.CreatedAt,
+		UpdatedAt:    c.UpdatedAt,
+		DeletedAt:    c.DeletedAt,
+	}
+}
+
+func CommentsToDomain(comments []Comment) []domain.Comment {
+	result := make([]domain.Comment, 0, len(comments))
+	for _, comment := range comments {
+		result = append(result, *CommentToDomain(&comment))
+	}
+	return result
+}
@@ -0,0 +1,47 @@
+package dao
+
+import (
+	"fmt"
+	"github.com/arumandesu/uniclubs-posts-service/internal/domain"
+)
+
+// FacetBucket is the group of the facet aggregation, the _id is a string for the tags, statuses, types and months
+// and the club id for the clubs
+type FacetBucket struct {
+	ID    any    `bson:"_id"`
+	Label string `bson:"label,omitempty"`
+	Count int64  `bson:"count"`
+}
+
+type EventFacets struct {
+	Tags     []FacetBucket `bson:"tags"`
+	Statuses []FacetBucket `bson:"statuses"`
+	Types    []FacetBucket `bson:"types"`
+	Clubs    []FacetBucket `bson:"clubs"`
+	Months   []FacetBucket `bson:"months"`
+}
+
+func (f EventFacets) ToDomain() *domain.EventFacets {
+	return &domain.EventFacets{
+		Tags:     toDomainFacetBuckets(f.Tags),
+		Statuses: toDomainFacetBuckets(f.Statuses),
+		Types:    toDomainFacetBuckets(f.Types),
+		Clubs:    toDomainFacetBuckets(f.Clubs),
+		Months:   toDomainFacetBuckets(f.Months),
+	}
+}
+
+func toDomainFacetBuckets(buckets []FacetBucket) []domain.FacetBucket {
+	result := make([]domain.FacetBucket, 0, len(buckets))
+	for _, bucket := range buckets {
+		if bucket.ID == nil || bucket.ID == "" {
+			continue
+		}
+		result = append(result, domain.FacetBucket{
+			Value: fmt.Sprint(bucket.ID),
+			Label: bucket.Label,
+			Count: bucket.Count,
+		})
+	}
+	return result
+}
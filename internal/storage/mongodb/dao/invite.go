@@ -9,6 +9,7 @@ type ClubInvite struct {
 	ID      primitive.ObjectID `bson:"_id"`
 	EventId primitive.ObjectID `bson:"event_id"`
 	Club    Club               `bson:"club"`
+	ByWhoId int64              `bson:"by_who_id,omitempty"`
 }
 
 type OrganizerInvite struct {
@@ -17,6 +18,7 @@ type OrganizerInvite struct {
 	ClubId  int64              `bson:"club_id"`
 	ByWhoId int64              `bson:"by_who_id"`
 	User    User               `bson:"user"`
+	Role    string             `bson:"role,omitempty"`
 }
 
 func ToDomainInvite(i ClubInvite) *domain.Invite {
@@ -25,7 +27,8 @@ func ToDomainInvite(i ClubInvite) *domain.Invite {
 		Event: domain.Event{
 			ID: i.EventId.Hex(),
 		},
-		Club: ToDomainClub(i.Club),
+		Club:    ToDomainClub(i.Club),
+		ByWhoId: i.ByWhoId,
 	}
 }
 
@@ -38,6 +41,7 @@ func ToDomainUserInvite(u OrganizerInvite) *domain.UserInvite {
 		ClubId:  u.ClubId,
 		ByWhoId: u.ByWhoId,
 		User:    ToDomainUser(u.User),
+		Role:    domain.OrganizerRole(u.Role),
 	}
 }
 
@@ -72,5 +76,6 @@ func ToDomainOrgInvite(u OrganizerInvite, event Event) domain.UserInvite {
 		ClubId:  u.ClubId,
 		ByWhoId: u.ByWhoId,
 		User:    ToDomainUser(u.User),
+		Role:    domain.OrganizerRole(u.Role),
 	}
 }
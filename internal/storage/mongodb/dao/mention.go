@@ -0,0 +1,47 @@
+package dao
+
+import "github.com/arumandesu/uniclubs-posts-service/internal/domain"
+
+type Mention struct {
+	Type  string `bson:"type"`
+	ID    int64  `bson:"id"`
+	Name  string `bson:"name"`
+	Start int    `bson:"start"`
+	End   int    `bson:"end"`
+}
+
+func ToMentions(mentions []domain.Mention) []Mention {
+	if mentions == nil {
+		return nil
+	}
+
+	result := make([]Mention, len(mentions))
+	for i, mention := range mentions {
+		result[i] = Mention{
+			Type:  mention.Type.String(),
+			ID:    mention.ID,
+			Name:  mention.Name,
+			Start: mention.Start,
+			End:   mention.End,
+		}
+	}
+	return result
+}
+
+func ToDomainMentions(mentions []Mention) []domain.Mention {
+	if mentions == nil {
+		return nil
+	}
+
+	result := make([]domain.Mention, len(mentions))
+	for i, mention := range mentions {
+		result[i] = domain.Mention{
+			Type:  domain.MentionType(mention.Type),
+			ID:    mention.ID,
+			Name:  mention.Name,
+			Start: mention.Start,
+			End:   mention.End,
+		}
+	}
+	return result
+}
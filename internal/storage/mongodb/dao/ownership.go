@@ -0,0 +1,74 @@
+package dao
+
+import (
+	"github.com/arumandesu/uniclubs-posts-service/internal/domain"
+	"time"
+)
+
+type OwnershipTransfer struct {
+	FromId      int64     `bson:"from_id"`
+	ToId        int64     `bson:"to_id"`
+	ByWhoId     int64     `bson:"by_who_id"`
+	RequestedAt time.Time `bson:"requested_at"`
+}
+
+type OwnershipRecord struct {
+	FromId        int64     `bson:"from_id"`
+	ToId          int64     `bson:"to_id"`
+	ByWhoId       int64     `bson:"by_who_id"`
+	TransferredAt time.Time `bson:"transferred_at"`
+}
+
+func (t *OwnershipTransfer) ToDomain() *domain.OwnershipTransfer {
+	if t == nil {
+		return nil
+	}
+
+	return &domain.OwnershipTransfer{
+		FromId:      t.FromId,
+		ToId:        t.ToId,
+		ByWhoId:     t.ByWhoId,
+		RequestedAt: t.RequestedAt,
+	}
+}
+
+func ToOwnershipTransfer(t *domain.OwnershipTransfer) *OwnershipTransfer {
+	if t == nil {
+		return nil
+	}
+
+	return &OwnershipTransfer{
+		FromId:      t.FromId,
+		ToId:        t.ToId,
+		ByWhoId:     t.ByWhoId,
+		RequestedAt: t.RequestedAt,
+	}
+}
+
+func ToDomainOwnershipHistory(records []OwnershipRecord) []domain.OwnershipRecord {
+	history := make([]domain.OwnershipRecord, 0, len(records))
+	for _, record := range records {
+		history = append(history, domain.OwnershipRecord{
+			FromId:        record.FromId,
+			ToId:          record.ToId,
+			ByWhoId:       record.ByWhoId,
+			TransferredAt: record.TransferredAt,
+		})
+	}
+
+	return history
+}
+
+func ToOwnershipHistory(records []domain.OwnershipRecord) []OwnershipRecord {
+	history := make([]OwnershipRecord, 0, len(records))
+	for _, record := range records {
+		history = append(history, OwnershipRecord{
+			FromId:        record.FromId,
+			ToId:          record.ToId,
+			ByWhoId:       record.ByWhoId,
+			TransferredAt: record.TransferredAt,
+		})
+	}
+
+	return history
+}
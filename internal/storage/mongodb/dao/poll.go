@@ -0,0 +1,109 @@
+package dao
+
+import (
+	"github.com/arumandesu/uniclubs-posts-service/internal/domain"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"time"
+)
+
+type Poll struct {
+	Options        []PollOption `bson:"options"`
+	MultipleChoice bool         `bson:"multiple_choice"`
+	ClosesAt       time.Time    `bson:"closes_at,omitempty"`
+	Anonymous      bool         `bson:"anonymous"`
+	HideResults    bool         `bson:"hide_results"`
+	MembersOnly    bool         `bson:"members_only"`
+}
+
+type PollOption struct {
+	ID   string `bson:"id"`
+	Text string `bson:"text"`
+}
+
+type Vote struct {
+	PostId    primitive.ObjectID `bson:"post_id"`
+	UserId    int64              `bson:"user_id"`
+	OptionIds []string           `bson:"option_ids"`
+	CreatedAt time.Time          `bson:"created_at"`
+}
+
+type PollOptionTally struct {
+	OptionId string  `bson:"_id"`
+	Votes    int64   `bson:"votes"`
+	Voters   []int64 `bson:"voters,omitempty"`
+}
+
+func PollFromDomain(poll *domain.Poll) *Poll {
+	if poll == nil {
+		return nil
+	}
+
+	options := make([]PollOption, 0, len(poll.Options))
+	for _, option := range poll.Options {
+		options = append(options, PollOption{ID: option.ID, Text: option.Text})
+	}
+
+	return &Poll{
+		Options:        options,
+		MultipleChoice: poll.MultipleChoice,
+		ClosesAt:       poll.ClosesAt,
+		Anonymous:      poll.Anonymous,
+		HideResults:    poll.HideResults,
+		MembersOnly:    poll.MembersOnly,
+	}
+}
+
+func PollToDomain(poll *Poll) *domain.Poll {
+	if poll == nil {
+		return nil
+	}
+
+	options := make([]domain.PollOption, 0, len(poll.Options))
+	for _, option := range poll.Options {
+		options = append(options, domain.PollOption{ID: option.ID, Text: option.Text})
+	}
+
+	return &domain.Poll{
+		Options:        options,
+		MultipleChoice: poll.MultipleChoice,
+		ClosesAt:       poll.ClosesAt,
+		Anonymous:      poll.Anonymous,
+		HideResults:    poll.HideResults,
+		MembersOnly:    poll.MembersOnly,
+	}
+}
+
+func VoteFromDomain(vote *domain.Vote) (*Vote, error) {
+	postId, err := primitive.ObjectIDFromHex(vote.PostId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Vote{
+		PostId:    postId,
+		UserId:    vote.UserId,
+		OptionIds: vote.OptionIds,
+		CreatedAt: vote.CreatedAt,
+	}, nil
+}
+
+func VoteToDomain(vote *Vote) *domain.Vote {
+	return &domain.Vote{
+		PostId:    vote.PostId.Hex(),
+		UserId:    vote.UserId,
+		OptionIds: vote.OptionIds,
+		CreatedAt: vote.CreatedAt,
+	}
+}
+
+func PollOptionTalliesToDomain(tallies []PollOptionTally) []domain.PollOptionResult {
+	results := make([]domain.PollOptionResult, 0, len(tallies))
+	for _, tally := range tallies {
+		results = append(results, domain.PollOptionResult{
+			OptionId: tally.OptionId,
+			Votes:    tally.Votes,
+			Voters:   tally.Voters,
+		})
+	}
+	return results
+}
@@ -7,47 +7,117 @@ import (
 )
 
 type Post struct {
-	ID            primitive.ObjectID `bson:"_id"`
-	Club          Club               `bson:"club"`
-	Title         string             `bson:"title"`
-	Description   string             `bson:"description"`
-	Tags          []string           `bson:"tags"`
-	CoverImages   []CoverImage       `bson:"cover_images"`
-	AttachedFiles []File             `bson:"attached_files"`
-	CreatedAt     time.Time          `bson:"created_at"`
-	UpdatedAt     time.Time          `bson:"updated_at"`
+	ID              primitive.ObjectID `bson:"_id"`
+	Club            Club               `bson:"club"`
+	Title           string             `bson:"title"`
+	Description     string             `bson:"description"`
+	DescriptionHTML string             `bson:"description_html"`
+	Excerpt         string             `bson:"excerpt"`
+	Mentions        []Mention          `bson:"mentions"`
+	Tags            []string           `bson:"tags"`
+	CoverImages     []CoverImage       `bson:"cover_images"`
+	AttachedFiles   []File             `bson:"attached_files"`
+	CreatedAt       time.Time          `bson:"created_at"`
+	UpdatedAt       time.Time          `bson:"updated_at"`
+	Hidden          bool               `bson:"hidden,omitempty"`
+	HiddenBy        int64              `bson:"hidden_by,omitempty"`
+	HiddenAt        time.Time          `bson:"hidden_at,omitempty"`
+	Pinned          bool               `bson:"pinned,omitempty"`
+	PinnedBy        int64              `bson:"pinned_by,omitempty"`
+	PinnedAt        time.Time          `bson:"pinned_at,omitempty"`
+	PinExpiresAt    time.Time          `bson:"pin_expires_at,omitempty"`
+	Status          string             `bson:"status,omitempty"`
+	PublishAt       time.Time          `bson:"publish_at,omitempty"`
+	ExpiresAt       *time.Time         `bson:"expires_at"`
+	// Reactions and ReactionsCount are changed only atomically by AddReaction and RemoveReaction
+	Reactions      map[string]int64 `bson:"reactions,omitempty"`
+	ReactionsCount int64            `bson:"reactions_count,omitempty"`
+	Type           string           `bson:"type,omitempty"`
+	Poll           *Poll            `bson:"poll,omitempty"`
+	EventIds       []string         `bson:"event_ids"`
+	Visibility     string           `bson:"visibility"`
+	DeletedAt      time.Time        `bson:"deleted_at,omitempty"`
+	DeletedBy      int64            `bson:"deleted_by,omitempty"`
 }
 
+// PostFromDomain converts the domain post to the dao one.
+// Hidden, pin fields, reactions and deletion fields are left empty on purpose, they are changed only through their own storage methods
+// so updating the post content can't overwrite them.
 func PostFromDomain(p *domain.Post) *Post {
 	objectID, _ := primitive.ObjectIDFromHex(p.ID)
 
 	return &Post{
-		ID:            objectID,
-		Club:          ClubFromDomain(p.Club),
-		Title:         p.Title,
-		Description:   p.Description,
-		Tags:          p.Tags,
-		CoverImages:   ToCoverImages(p.CoverImages),
-		AttachedFiles: ToFiles(p.AttachedFiles),
-		CreatedAt:     p.CreatedAt,
-		UpdatedAt:     p.UpdatedAt,
+		ID:              objectID,
+		Club:            ClubFromDomain(p.Club),
+		Title:           p.Title,
+		Description:     p.Description,
+		DescriptionHTML: p.DescriptionHTML,
+		Excerpt:         p.Excerpt,
+		Mentions:        ToMentions(p.Mentions),
+		Tags:            p.Tags,
+		CoverImages:     ToCoverImages(p.CoverImages),
+		AttachedFiles:   ToFiles(p.AttachedFiles),
+		CreatedAt:       p.CreatedAt,
+		UpdatedAt:       p.UpdatedAt,
+		Status:          p.Status.String(),
+		PublishAt:       p.PublishAt,
+		ExpiresAt:       toNullableTime(p.ExpiresAt),
+		Type:            p.Type.String(),
+		Poll:            PollFromDomain(p.Poll),
+		EventIds:        p.EventIds,
+		Visibility:      p.Visibility.String(),
 	}
 }
 
 func PostToDomain(p *Post) *domain.Post {
 	return &domain.Post{
-		ID:            p.ID.Hex(),
-		Club:          ToDomainClub(p.Club),
-		Title:         p.Title,
-		Description:   p.Description,
-		Tags:          p.Tags,
-		CoverImages:   ToDomainCoverImages(p.CoverImages),
-		AttachedFiles: ToDomainFiles(p.AttachedFiles),
-		CreatedAt:     p.CreatedAt,
-		UpdatedAt:     p.UpdatedAt,
+		ID:              p.ID.Hex(),
+		Club:            ToDomainClub(p.Club),
+		Title:           p.Title,
+		Description:     p.Description,
+		DescriptionHTML: p.DescriptionHTML,
+		Excerpt:         p.Excerpt,
+		Mentions:        ToDomainMentions(p.Mentions),
+		Tags:            p.Tags,
+		CoverImages:     ToDomainCoverImages(p.CoverImages),
+		AttachedFiles:   ToDomainFiles(p.AttachedFiles),
+		CreatedAt:       p.CreatedAt,
+		UpdatedAt:       p.UpdatedAt,
+		Hidden:          p.Hidden,
+		HiddenBy:        p.HiddenBy,
+		HiddenAt:        p.HiddenAt,
+		Pinned:          p.Pinned,
+		PinnedBy:        p.PinnedBy,
+		PinnedAt:        p.PinnedAt,
+		PinExpiresAt:    p.PinExpiresAt,
+		Status:          domain.PostStatus(p.Status),
+		PublishAt:       p.PublishAt,
+		ExpiresAt:       fromNullableTime(p.ExpiresAt),
+		Reactions:       ToDomainReactions(p.Reactions, p.ReactionsCount),
+		Type:            domain.PostType(p.Type),
+		Poll:            PollToDomain(p.Poll),
+		EventIds:        p.EventIds,
+		DeletedAt:       p.DeletedAt,
+		DeletedBy:       p.DeletedBy,
+		Visibility:      domain.PostVisibility(p.Visibility),
 	}
 }
 
+// toNullableTime converts zero time to nil so it is stored as null and can be cleared by the whole document update
+func toNullableTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
+
+func fromNullableTime(t *time.Time) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+	return *t
+}
+
 func PostsToDomain(posts []Post) []domain.Post {
 	if posts == nil {
 		return nil
@@ -0,0 +1,45 @@
+package dao
+
+import (
+	"github.com/arumandesu/uniclubs-posts-service/internal/domain"
+	"time"
+)
+
+type Reaction struct {
+	TargetType string    `bson:"target_type"`
+	TargetId   string    `bson:"target_id"`
+	UserId     int64     `bson:"user_id"`
+	Type       string    `bson:"type"`
+	CreatedAt  time.Time `bson:"created_at"`
+}
+
+func ReactionFromDomain(r *domain.Reaction) Reaction {
+	return Reaction{
+		TargetType: r.TargetType.String(),
+		TargetId:   r.TargetId,
+		UserId:     r.UserId,
+		Type:       r.Type.String(),
+		CreatedAt:  r.CreatedAt,
+	}
+}
+
+func ToDomainReactionTypes(reactions []Reaction) []domain.ReactionType {
+	types := make([]domain.ReactionType, 0, len(reactions))
+	for _, reaction := range reactions {
+		types = append(types, domain.ReactionType(reaction.Type))
+	}
+	return types
+}
+
+func ToDomainReactions(counts map[string]int64, total int64) domain.Reactions {
+	reactions := domain.Reactions{Total: total}
+	if len(counts) == 0 {
+		return reactions
+	}
+
+	reactions.Counts = make(map[domain.ReactionType]int64, len(counts))
+	for reactionType, count := range counts {
+		reactions.Counts[domain.ReactionType(reactionType)] = count
+	}
+	return reactions
+}
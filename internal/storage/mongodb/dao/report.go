@@ -0,0 +1,99 @@
+package dao
+
+import (
+	"github.com/arumandesu/uniclubs-posts-service/internal/domain"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"time"
+)
+
+type Report struct {
+	ID          primitive.ObjectID `bson:"_id"`
+	TargetType  string             `bson:"target_type"`
+	TargetId    string             `bson:"target_id"`
+	ReporterId  int64              `bson:"reporter_id"`
+	Reason      string             `bson:"reason"`
+	Text        string             `bson:"text,omitempty"`
+	Status      string             `bson:"status"`
+	Action      string             `bson:"action,omitempty"`
+	ModeratorId int64              `bson:"moderator_id,omitempty"`
+	Note        string             `bson:"note,omitempty"`
+	CreatedAt   time.Time          `bson:"created_at"`
+	ClosedAt    time.Time          `bson:"closed_at,omitempty"`
+}
+
+type ModerationQueueItem struct {
+	TargetType      string    `bson:"target_type"`
+	TargetId        string    `bson:"target_id"`
+	ReportsCount    int64     `bson:"reports_count"`
+	Reasons         []string  `bson:"reasons"`
+	FirstReportedAt time.Time `bson:"first_reported_at"`
+	LastReportedAt  time.Time `bson:"last_reported_at"`
+}
+
+func ReportFromDomain(r *domain.Report) (*Report, error) {
+	objectID, err := primitive.ObjectIDFromHex(r.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Report{
+		ID:          objectID,
+		TargetType:  r.TargetType.String(),
+		TargetId:    r.TargetId,
+		ReporterId:  r.ReporterId,
+		Reason:      r.Reason.String(),
+		Text:        r.Text,
+		Status:      r.Status.String(),
+		Action:      r.Action.String(),
+		ModeratorId: r.ModeratorId,
+		Note:        r.Note,
+		CreatedAt:   r.CreatedAt,
+		ClosedAt:    r.ClosedAt,
+	}, nil
+}
+
+func ReportToDomain(r *Report) *domain.Report {
+	return &domain.Report{
+		ID:          r.ID.Hex(),
+		TargetType:  domain.TargetType(r.TargetType),
+		TargetId:    r.TargetId,
+		ReporterId:  r.ReporterId,
+		Reason:      domain.ReportReason(r.Reason),
+		Text:        r.Text,
+		Status:      domain.ReportStatus(r.Status),
+		Action:      domain.ModerationAction(r.Action),
+		ModeratorId: r.ModeratorId,
+		Note:        r.Note,
+		CreatedAt:   r.CreatedAt,
+		ClosedAt:    r.ClosedAt,
+	}
+}
+
+func ReportsToDomain(reports []Report) []domain.Report {
+	result := make([]domain.Report, 0, len(reports))
+	for i := range reports {
+		result = append(result, *ReportToDomain(&reports[i]))
+	}
+	return result
+}
+
+// ToDomainModerationQueue converts the grouped reports, the reasons of the group are counted by the reason
+func ToDomainModerationQueue(items []ModerationQueueItem) []domain.ModerationQueueItem {
+	result := make([]domain.ModerationQueueItem, 0, len(items))
+	for _, item := range items {
+		reasons := make(map[domain.ReportReason]int64, len(item.Reasons))
+		for _, reason := range item.Reasons {
+			reasons[domain.ReportReason(reason)]++
+		}
+
+		result = append(result, domain.ModerationQueueItem{
+			TargetType:      domain.TargetType(item.TargetType),
+			TargetId:        item.TargetId,
+			ReportsCount:    item.ReportsCount,
+			Reasons:         reasons,
+			FirstReportedAt: item.FirstReportedAt,
+			LastReportedAt:  item.LastReportedAt,
+		})
+	}
+	return result
+}
@@ -0,0 +1,74 @@
+package dao
+
+import (
+	"github.com/arumandesu/uniclubs-posts-service/internal/domain"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"time"
+)
+
+type PostRevision struct {
+	ID            primitive.ObjectID `bson:"_id"`
+	PostId        string             `bson:"post_id"`
+	EditorId      int64              `bson:"editor_id"`
+	CreatedAt     time.Time          `bson:"created_at"`
+	ChangedFields []string           `bson:"changed_fields"`
+	Content       PostContent        `bson:"content"`
+	RestoredFrom  string             `bson:"restored_from,omitempty"`
+}
+
+type PostContent struct {
+	Title         string       `bson:"title"`
+	Description   string       `bson:"description"`
+	Tags          []string     `bson:"tags"`
+	CoverImages   []CoverImage `bson:"cover_images"`
+	AttachedFiles []File       `bson:"attached_files"`
+}
+
+func PostRevisionFromDomain(r *domain.PostRevision) (*PostRevision, error) {
+	objectID, err := primitive.ObjectIDFromHex(r.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PostRevision{
+		ID:            objectID,
+		PostId:        r.PostId,
+		EditorId:      r.EditorId,
+		CreatedAt:     r.CreatedAt,
+		ChangedFields: r.ChangedFields,
+		Content: PostContent{
+			Title:         r.Content.Title,
+			Description:   r.Content.Description,
+			Tags:          r.Content.Tags,
+			CoverImages:   ToCoverImages(r.Content.CoverImages),
+			AttachedFiles: ToFiles(r.Content.AttachedFiles),
+		},
+		RestoredFrom: r.RestoredFrom,
+	}, nil
+}
+
+func PostRevisionToDomain(r *PostRevision) *domain.PostRevision {
+	return &domain.PostRevision{
+		ID:            r.ID.Hex(),
+		PostId:        r.PostId,
+		EditorId:      r.EditorId,
+		CreatedAt:     r.CreatedAt,
+		ChangedFields: r.ChangedFields,
+		Content: domain.PostContent{
+			Title:         r.Content.Title,
+			Description:   r.Content.Description,
+			Tags:          r.Content.Tags,
+			CoverImages:   ToDomainCoverImages(r.Content.CoverImages),
+			AttachedFiles: ToDomainFiles(r.Content.AttachedFiles),
+		},
+		RestoredFrom: r.RestoredFrom,
+	}
+}
+
+func PostRevisionsToDomain(revisions []PostRevision) []domain.PostRevision {
+	result := make([]domain.PostRevision, 0, len(revisions))
+	for _, revision := range revisions {
+		result = append(result, *PostRevisionToDomain(&revision))
+	}
+	return result
+}
@@ -0,0 +1,34 @@
+package dao
+
+import (
+	"github.com/arumandesu/uniclubs-posts-service/internal/domain"
+	"time"
+)
+
+type Tag struct {
+	Slug       string    `bson:"_id"`
+	Name       string    `bson:"name"`
+	Aliases    []string  `bson:"aliases,omitempty"`
+	UsageCount int64     `bson:"usage_count"`
+	CreatedAt  time.Time `bson:"created_at"`
+	UpdatedAt  time.Time `bson:"updated_at"`
+}
+
+func TagToDomain(t *Tag) *domain.Tag {
+	return &domain.Tag{
+		Slug:       t.Slug,
+		Name:       t.Name,
+		Aliases:    t.Aliases,
+		UsageCount: t.UsageCount,
+		CreatedAt:  t.CreatedAt,
+		UpdatedAt:  t.UpdatedAt,
+	}
+}
+
+func TagsToDomain(tags []Tag) []domain.Tag {
+	result := make([]domain.Tag, 0, len(tags))
+	for i := range tags {
+		result = append(result, *TagToDomain(&tags[i]))
+	}
+	return result
+}
@@ -14,8 +14,9 @@ type User struct {
 
 type Organizer struct {
 	User    `bson:",inline"`
-	ClubId  int64 `bson:"club_id"`
-	ByWhoId int64 `bson:"by_who_id,omitempty"`
+	ClubId  int64  `bson:"club_id"`
+	ByWhoId int64  `bson:"by_who_id,omitempty"`
+	Role    string `bson:"role,omitempty"`
 }
 
 // Into dao
@@ -34,6 +35,7 @@ func OrganizerFromDomainUser(user domain.User, clubId int64) Organizer {
 	return Organizer{
 		User:   UserFromDomainUser(user),
 		ClubId: clubId,
+		Role:   domain.OrganizerRoleCoOwner.String(),
 	}
 }
 
@@ -42,6 +44,7 @@ func ToOrganizer(organizer domain.Organizer) Organizer {
 		User:    UserFromDomainUser(organizer.User),
 		ClubId:  organizer.ClubId,
 		ByWhoId: organizer.ByWhoId,
+		Role:    organizer.Role.String(),
 	}
 }
 
@@ -78,6 +81,7 @@ func ToDomainOrganizer(organizer Organizer) domain.Organizer {
 		User:    ToDomainUser(organizer.User),
 		ClubId:  organizer.ClubId,
 		ByWhoId: organizer.ByWhoId,
+		Role:    domain.OrganizerRole(organizer.Role),
 	}
 }
 
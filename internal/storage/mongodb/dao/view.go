@@ -0,0 +1,57 @@
+package dao
+
+import (
+	"github.com/arumandesu/uniclubs-posts-service/internal/domain"
+	"time"
+)
+
+// PostView marks that the user has viewed the post during the day, it is unique per post, user and day
+type PostView struct {
+	PostId   string    `bson:"post_id"`
+	UserId   int64     `bson:"user_id"`
+	Day      time.Time `bson:"day"`
+	Source   string    `bson:"source"`
+	ViewedAt time.Time `bson:"viewed_at"`
+}
+
+// PostDailyViews contains the view counters of the post during the day, they are incremented on every counted view
+type PostDailyViews struct {
+	PostId         string           `bson:"post_id"`
+	Day            time.Time        `bson:"day"`
+	Views          int64            `bson:"views"`
+	AnonymousViews int64            `bson:"anonymous_views"`
+	Sources        map[string]int64 `bson:"sources"`
+}
+
+func PostViewFromDomain(v *domain.PostView) *PostView {
+	return &PostView{
+		PostId:   v.PostId,
+		UserId:   v.UserId,
+		Day:      v.Day(),
+		Source:   v.Source.String(),
+		ViewedAt: v.ViewedAt,
+	}
+}
+
+func ToDomainDailyViews(days []PostDailyViews) []domain.PostDailyViews {
+	result := make([]domain.PostDailyViews, 0, len(days))
+	for _, day := range days {
+		result = append(result, domain.PostDailyViews{
+			Day:            day.Day,
+			Views:          day.Views,
+			AnonymousViews: day.AnonymousViews,
+		})
+	}
+	return result
+}
+
+// SumViewSources sums the views of the days by the view source
+func SumViewSources(days []PostDailyViews) map[domain.ViewSource]int64 {
+	sources := make(map[domain.ViewSource]int64)
+	for _, day := range days {
+		for source, count := range day.Sources {
+			sources[domain.ViewSource(source)] += count
+		}
+	}
+	return sources
+}
@@ -67,6 +67,33 @@ func (s *Storage) GetEvent(ctx context.Context, id string) (*domain.Event, error
 	return dao.ToDomainEvent(event), nil
 }
 
+// GetEventsByIds returns the found events in no particular order, missing events are skipped
+func (s *Storage) GetEventsByIds(ctx context.Context, ids []string) ([]domain.Event, error) {
+	const op = "storage.mongodb.event.getEventsByIds"
+
+	objectIDs := make([]primitive.ObjectID, 0, len(ids))
+	for _, id := range ids {
+		objectID, err := primitive.ObjectIDFromHex(id)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, storage.ErrInvalidID)
+		}
+		objectIDs = append(objectIDs, objectID)
+	}
+
+	cursor, err := s.eventsCollection.Find(ctx, bson.M{"_id": bson.M{"$in": objectIDs}})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer cursor.Close(ctx)
+
+	var events []dao.Event
+	if err = cursor.All(ctx, &events); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return dao.ToDomainEvents(events), nil
+}
+
 func (s *Storage) UpdateEvent(ctx context.Context, event *domain.Event) (*domain.Event, error) {
 	const op = "storage.mongodb.event.updateEvent"
 
@@ -109,35 +136,98 @@ func (s *Storage) DeleteEventById(ctx context.Context, eventId string) error {
 func (s *Storage) ListEvents(ctx context.Context, filters domain.EventsFilter) ([]domain.Event, *domain.PaginationMetadata, error) {
 	const op = "storage.mongodb.event.listEvents"
 
-	filter := constructEventFilter(filters)
+	filter := constructEventFilter(filters, time.Now())
+
+	opts := options.Find()
+	if filters.Query != "" {
+		opts.SetProjection(bson.M{"score": bson.M{"$meta": "textScore"}})
+	}
 
-	totalRecords, err := s.eventsCollection.CountDocuments(ctx, filter)
+	page, err := findPage(ctx, s.eventsCollection, filter, constructEventListSortBy(filters.BaseFilter), filters.BaseFilter, opts)
 	if err != nil {
 		return nil, nil, fmt.Errorf("%s: %w", op, err)
 	}
-	if totalRecords == 0 {
+	if page.NoMatches {
 		return nil, nil, fmt.Errorf("%s: %w", op, storage.ErrEventNotFound)
 	}
 
-	opts := options.Find()
-	opts.SetSort(constructEventSortBy(filters.BaseFilter))
-	opts.SetSkip(int64(filters.Offset()))
-	opts.SetLimit(int64(filters.Limit()))
+	events, err := decodeDocuments[dao.Event](page.Documents)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
 
-	cursor, err := s.eventsCollection.Find(ctx, filter, opts)
+	return dao.ToDomainEvents(events), &page.Metadata, nil
+}
+
+/*
+GetEventFacets counts the events matching the list filter by tag, status, type, club and start month with a single $facet aggregation.
+
+	Only the published events are counted and the events hidden for non-members are always excluded,
+	the facets are not resolved against the membership of the user.
+	The clubs are the collaborator clubs of the event, the same ones the club filter matches.
+*/
+func (s *Storage) GetEventFacets(ctx context.Context, filters domain.EventsFilter) (*domain.EventFacets, error) {
+	const op = "storage.mongodb.event.getEventFacets"
+
+	filter := constructEventFilter(filters, time.Now())
+	filter["status"] = bson.M{"$in": filters.FacetStatuses()}
+	// $and keeps the hidden events excluded even when the filter asks for them
+	filter["$and"] = []bson.M{{"is_hidden_for_non_members": bson.M{"$ne": true}}}
+
+	countDesc := bson.M{"$sort": bson.D{{Key: "count", Value: -1}, {Key: "_id", Value: 1}}}
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: filter}},
+		{{Key: "$facet", Value: bson.M{
+			"tags": bson.A{
+				bson.M{"$unwind": "$tags"},
+				bson.M{"$group": bson.M{"_id": "$tags", "count": bson.M{"$sum": 1}}},
+				countDesc,
+				bson.M{"$limit": domain.MaxFacetBuckets},
+			},
+			"statuses": bson.A{
+				bson.M{"$group": bson.M{"_id": "$status", "count": bson.M{"$sum": 1}}},
+				countDesc,
+			},
+			"types": bson.A{
+				bson.M{"$group": bson.M{"_id": "$type", "count": bson.M{"$sum": 1}}},
+				countDesc,
+			},
+			"clubs": bson.A{
+				bson.M{"$unwind": "$collaborator_clubs"},
+				bson.M{"$group": bson.M{
+					"_id":   "$collaborator_clubs._id",
+					"label": bson.M{"$first": "$collaborator_clubs.name"},
+					"count": bson.M{"$sum": 1},
+				}},
+				countDesc,
+				bson.M{"$limit": domain.MaxFacetBuckets},
+			},
+			"months": bson.A{
+				bson.M{"$match": bson.M{"start_date": bson.M{"$type": "date"}}},
+				bson.M{"$group": bson.M{
+					"_id":   bson.M{"$dateToString": bson.M{"format": "%Y-%m", "date": "$start_date"}},
+					"count": bson.M{"$sum": 1},
+				}},
+				bson.M{"$sort": bson.M{"_id": 1}},
+			},
+		}}},
+	}
+
+	cursor, err := s.eventsCollection.Aggregate(ctx, pipeline)
 	if err != nil {
-		return nil, nil, handleError(op, err)
+		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 	defer cursor.Close(ctx)
 
-	var events []dao.Event
-	if err = cursor.All(ctx, &events); err != nil {
-		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	var facets []dao.EventFacets
+	if err = cursor.All(ctx, &facets); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if len(facets) == 0 {
+		return dao.EventFacets{}.ToDomain(), nil
 	}
 
-	paginationMetadata := domain.CalculatePaginationMetadata(int32(totalRecords), filters.Page, filters.PageSize)
-
-	return dao.ToDomainEvents(events), &paginationMetadata, nil
+	return facets[0].ToDomain(), nil
 }
 
 func handleError(op string, err error) error {
@@ -147,11 +237,12 @@ func handleError(op string, err error) error {
 	return fmt.Errorf("%s: %w", op, err)
 }
 
-func constructEventFilter(filters domain.EventsFilter) bson.M {
+func constructEventFilter(filters domain.EventsFilter, now time.Time) bson.M {
 	filter := bson.M{}
 
+	// the query is matched against the text index on the title, description and tags
 	if filters.Query != "" {
-		filter["title"] = bson.M{"$regex": filters.Query, "$options": "i"}
+		filter["$text"] = bson.M{"$search": filters.Query}
 	}
 
 	if filters.ClubId != 0 {
@@ -169,13 +260,10 @@ func constructEventFilter(filters domain.EventsFilter) bson.M {
 		filter["tags"] = bson.M{"$in": filters.Tags}
 	}
 
-	if !filters.FromDate.IsZero() {
-		filter["created_at"] = bson.M{"$gte": filters.FromDate}
-	}
-
-	if !filters.ToDate.IsZero() {
-		filter["created_at"] = bson.M{"$lte": filters.ToDate}
-	}
+	startDate, endDate := filters.DateRanges(now)
+	addTimeRange(filter, "start_date", startDate)
+	addTimeRange(filter, "end_date", endDate)
+	addTimeRange(filter, "created_at", filters.CreatedAt)
 
 	if filters.Status != nil && len(filters.Status) > 0 {
 		filter["status"] = bson.M{"$in": filters.Status}
@@ -194,21 +282,45 @@ func constructEventFilter(filters domain.EventsFilter) bson.M {
 	return filter
 }
 
-func constructEventSortBy(filter domain.BaseFilter) bson.M {
-	sortBy := bson.M{}
+// addTimeRange adds the bounds of the range to the field condition, the zero bounds are skipped
+func addTimeRange(filter bson.M, field string, r domain.TimeRange) {
+	if r.IsZero() {
+		return
+	}
+
+	condition := bson.M{}
+	if !r.From.IsZero() {
+		condition["$gte"] = r.From
+	}
+	if !r.To.IsZero() {
+		condition["$lte"] = r.To
+	}
+	filter[field] = condition
+}
+
+// constructEventListSortBy sorts the searched events by the text score, it is the default sort when the query is present.
+// Without the query the relevance sort falls back to the default one.
+func constructEventListSortBy(filter domain.BaseFilter) bson.D {
+	if filter.Query != "" && (filter.SortBy == "" || filter.SortBy == domain.SortByRelevance) {
+		return bson.D{{Key: "score", Value: bson.M{"$meta": "textScore"}}, {Key: "_id", Value: -1}}
+	}
+
+	return append(constructEventSortBy(filter), bson.E{Key: "_id", Value: 1})
+}
 
+func constructEventSortBy(filter domain.BaseFilter) bson.D {
 	switch filter.SortBy {
 	case domain.SortByDate:
-		sortBy["created_at"] = constructEventSortOrder(filter.SortOrder)
+		return bson.D{{Key: "created_at", Value: constructEventSortOrder(filter.SortOrder)}}
 	case domain.SortByParticipants:
-		sortBy["participants"] = constructEventSortOrder(filter.SortOrder)
+		return bson.D{{Key: "participants", Value: constructEventSortOrder(filter.SortOrder)}}
 	case domain.SortByType:
-		sortBy["type"] = constructEventSortOrder(filter.SortOrder)
+		return bson.D{{Key: "type", Value: constructEventSortOrder(filter.SortOrder)}}
+	case domain.SortByReactions:
+		return bson.D{{Key: "reactions_count", Value: constructEventSortOrder(filter.SortOrder)}}
 	default:
-		sortBy["start_date"] = 1
+		return bson.D{{Key: "start_date", Value: 1}}
 	}
-
-	return sortBy
 }
 
 func constructEventSortOrder(sortOrder domain.SortOrder) int {
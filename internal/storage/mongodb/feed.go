@@ -0,0 +1,148 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"github.com/arumandesu/uniclubs-posts-service/internal/domain"
+	dtos "github.com/arumandesu/uniclubs-posts-service/internal/domain/dto"
+	"github.com/arumandesu/uniclubs-posts-service/internal/storage/mongodb/dao"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// feedSort orders the club feed by the publication time, the newest first
+var feedSort = bson.D{{Key: "feed_at", Value: -1}, {Key: "_id", Value: -1}}
+
+/*
+ListClubFeed returns the published posts of the club and the published events the club hosts or collaborates on
+as one stream merged with $unionWith, so a single cursor paginates across both.
+
+	The posts follow the visibility rules of the posts list for the audience of the club,
+	the events hidden from non-members are listed to the club members only.
+	The publication time of the scheduled posts is publish_at, the legacy documents fall back to created_at.
+*/
+func (s *Storage) ListClubFeed(ctx context.Context, dto *dtos.ListClubFeed) ([]domain.FeedItem, *domain.PaginationMetadata, error) {
+	const op = "storage.mongodb.feed.listClubFeed"
+
+	postFilter, eventFilter := constructFeedFilters(dto)
+
+	var result documentsPage
+	if !dto.SkipCount {
+		postsCount, err := s.postsCollection.CountDocuments(ctx, postFilter)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%s: %w", op, err)
+		}
+		eventsCount, err := s.eventsCollection.CountDocuments(ctx, eventFilter)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%s: %w", op, err)
+		}
+		result.Metadata = domain.CalculatePaginationMetadata(int32(postsCount+eventsCount), dto.Page, dto.PageSize)
+	}
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: postFilter}},
+		{{Key: "$set", Value: feedFields(domain.FeedItemPost, "$publish_at")}},
+		{{Key: "$unionWith", Value: bson.M{
+			"coll": s.eventsCollection.Name(),
+			"pipeline": bson.A{
+				bson.M{"$match": eventFilter},
+				bson.M{"$set": feedFields(domain.FeedItemEvent, "$published_at")},
+			},
+		}}},
+	}
+
+	if dto.Cursor != "" {
+		keyset, err := decodeCursor(dto.Cursor, feedSort)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%s: %w", op, err)
+		}
+		pipeline = append(pipeline, bson.D{{Key: "$match", Value: keyset}})
+	}
+	pipeline = append(pipeline, bson.D{{Key: "$sort", Value: feedSort}})
+	if dto.Cursor == "" && dto.Offset() > 0 {
+		pipeline = append(pipeline, bson.D{{Key: "$skip", Value: dto.Offset()}})
+	}
+	// one more document is fetched to know whether there is the next page
+	pipeline = append(pipeline, bson.D{{Key: "$limit", Value: dto.Limit() + 1}})
+
+	cursor, err := s.postsCollection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer cursor.Close(ctx)
+
+	if err = cursor.All(ctx, &result.Documents); err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if err = result.trim(feedSort, dto.BaseFilter, true); err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	items, err := decodeFeedItems(result.Documents)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return items, &result.Metadata, nil
+}
+
+// constructFeedFilters returns the filters of the posts and the events shown in the club feed
+func constructFeedFilters(dto *dtos.ListClubFeed) (bson.M, bson.M) {
+	posts := &dtos.ListPostsRequest{ClubId: dto.ClubId}
+	if dto.Audience.IsMember {
+		posts.MemberClubIds = []int64{dto.ClubId}
+	}
+	if dto.Audience.CanManage {
+		posts.ManagedClubIds = []int64{dto.ClubId}
+	}
+	postFilter := constructPostFilter(posts)
+	postFilter["$and"] = []bson.M{constructPostVisibilityFilter(posts)}
+
+	eventFilter := bson.M{
+		"collaborator_clubs._id": dto.ClubId,
+		"status":                 bson.M{"$in": domain.FeedEventStatuses},
+	}
+	if !dto.Audience.IsMember {
+		eventFilter["is_hidden_for_non_members"] = bson.M{"$ne": true}
+	}
+
+	return postFilter, eventFilter
+}
+
+func feedFields(itemType domain.FeedItemType, publishedAt string) bson.M {
+	return bson.M{
+		"feed_type": itemType.String(),
+		"feed_at":   bson.M{"$ifNull": bson.A{publishedAt, "$created_at"}},
+	}
+}
+
+func decodeFeedItems(documents []bson.Raw) ([]domain.FeedItem, error) {
+	items := make([]domain.FeedItem, 0, len(documents))
+	for _, document := range documents {
+		item := domain.FeedItem{
+			Type: domain.FeedItemType(document.Lookup("feed_type").StringValue()),
+			Time: document.Lookup("feed_at").Time(),
+		}
+
+		switch item.Type {
+		case domain.FeedItemPost:
+			var post dao.Post
+			if err := bson.Unmarshal(document, &post); err != nil {
+				return nil, err
+			}
+			item.Post = dao.PostToDomain(&post)
+		case domain.FeedItemEvent:
+			var event dao.Event
+			if err := bson.Unmarshal(document, &event); err != nil {
+				return nil, err
+			}
+			item.Event = dao.ToDomainEvent(event)
+		default:
+			return nil, fmt.Errorf("unknown feed item type %q", item.Type)
+		}
+
+		items = append(items, item)
+	}
+
+	return items, nil
+}
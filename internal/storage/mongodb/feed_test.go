@@ -0,0 +1,54 @@
+package mongodb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/arumandesu/uniclubs-posts-service/internal/domain"
+	dtos "github.com/arumandesu/uniclubs-posts-service/internal/domain/dto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestConstructFeedFilters(t *testing.T) {
+	_, eventFilter := constructFeedFilters(&dtos.ListClubFeed{ClubId: 1})
+	assert.Equal(t, bson.M{"$ne": true}, eventFilter["is_hidden_for_non_members"], "non-members don't see the hidden events")
+	assert.Equal(t, int64(1), eventFilter["collaborator_clubs._id"])
+
+	postFilter, eventFilter := constructFeedFilters(&dtos.ListClubFeed{ClubId: 1, Audience: domain.PostAudience{IsMember: true}})
+	assert.NotContains(t, eventFilter, "is_hidden_for_non_members")
+	assert.Equal(t, []bson.M{{"$or": []bson.M{
+		{"visibility": bson.M{"$nin": restrictedPostVisibilities}},
+		{"visibility": domain.PostVisibilityMembers.String(), "club._id": bson.M{"$in": []int64{1}}},
+	}}}, postFilter["$and"])
+}
+
+func TestDecodeFeedItems(t *testing.T) {
+	publishedAt := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	postId, eventId := primitive.NewObjectID(), primitive.NewObjectID()
+
+	post, err := bson.Marshal(bson.M{"_id": postId, "title": "Post", "feed_type": "POST", "feed_at": publishedAt})
+	require.NoError(t, err)
+	event, err := bson.Marshal(bson.M{"_id": eventId, "title": "Event", "feed_type": "EVENT", "feed_at": publishedAt})
+	require.NoError(t, err)
+
+	items, err := decodeFeedItems([]bson.Raw{post, event})
+	require.NoError(t, err)
+	require.Len(t, items, 2)
+
+	assert.Equal(t, domain.FeedItemPost, items[0].Type)
+	assert.Equal(t, postId.Hex(), items[0].Post.ID)
+	assert.Nil(t, items[0].Event)
+	assert.True(t, publishedAt.Equal(items[0].Time))
+
+	assert.Equal(t, domain.FeedItemEvent, items[1].Type)
+	assert.Equal(t, eventId.Hex(), items[1].Event.ID)
+	assert.Nil(t, items[1].Post)
+
+	unknown, err := bson.Marshal(bson.M{"_id": postId, "feed_type": "COMMENT", "feed_at": publishedAt})
+	require.NoError(t, err)
+	_, err = decodeFeedItems([]bson.Raw{unknown})
+	assert.Error(t, err)
+}
@@ -30,6 +30,7 @@ func (s *Storage) CreateJoinRequestToUser(ctx context.Context, dto *dtos.SendJoi
 		ClubId:  dto.TargetClubId,
 		ByWhoId: dto.UserId,
 		User:    dao.UserFromDomainUser(dto.Target),
+		Role:    dto.Role.String(),
 	}
 
 	_, err = s.invitesCollection.InsertOne(ctx, invite)
@@ -177,6 +178,7 @@ func (s *Storage) CreateJoinRequestToClub(ctx context.Context, dto *dtos.SendJoi
 		ID:      primitive.NewObjectID(),
 		EventId: eventObjectId,
 		Club:    dao.ClubFromDomain(dto.Club),
+		ByWhoId: dto.UserId,
 	}
 
 	_, err = s.invitesCollection.InsertOne(ctx, invite)
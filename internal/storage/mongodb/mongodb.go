@@ -21,6 +21,15 @@ type collections struct {
 	participantsCollection *mongo.Collection
 	bansCollection         *mongo.Collection
 	postsCollection        *mongo.Collection
+	commentsCollection     *mongo.Collection
+	reactionsCollection    *mongo.Collection
+	votesCollection        *mongo.Collection
+	revisionsCollection    *mongo.Collection
+	viewsCollection        *mongo.Collection
+	viewStatsCollection    *mongo.Collection
+	reportsCollection      *mongo.Collection
+	tagsCollection         *mongo.Collection
+	clubPinsCollection     *mongo.Collection
 }
 
 func New(ctx context.Context, cfg config.MongoDB) (*Storage, error) {
@@ -43,6 +52,15 @@ func New(ctx context.Context, cfg config.MongoDB) (*Storage, error) {
 	participantsCollection := db.Collection("participants")
 	bansCollection := db.Collection("bans")
 	postsCollection := db.Collection("posts")
+	commentsCollection := db.Collection("comments")
+	reactionsCollection := db.Collection("reactions")
+	votesCollection := db.Collection("votes")
+	revisionsCollection := db.Collection("post_revisions")
+	viewsCollection := db.Collection("post_views")
+	viewStatsCollection := db.Collection("post_view_stats")
+	reportsCollection := db.Collection("reports")
+	tagsCollection := db.Collection("tags")
+	clubPinsCollection := db.Collection("club_pins")
 
 	// Create text index on the 'title', 'description', 'tags' fields
 	eventIndex := mongo.IndexModel{
@@ -76,6 +94,136 @@ func New(ctx context.Context, cfg config.MongoDB) (*Storage, error) {
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
+	// the events of the user: the attended events and the recommendation candidates they have joined or are banned from
+	userEventsIndex := mongo.IndexModel{Keys: bson.D{{Key: "user._id", Value: 1}, {Key: "joined_at", Value: -1}}}
+	_, err = participantsCollection.Indexes().CreateOne(ctx, userEventsIndex)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	_, err = bansCollection.Indexes().CreateOne(ctx, mongo.IndexModel{Keys: bson.D{{Key: "user._id", Value: 1}, {Key: "event_id", Value: 1}}})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	_, err = postsCollection.Indexes().CreateOne(ctx, mongo.IndexModel{Keys: bson.D{{Key: "event_ids", Value: 1}}})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	commentsIndex := mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "target_type", Value: 1},
+			{Key: "target_id", Value: 1},
+			{Key: "parent_id", Value: 1},
+			{Key: "created_at", Value: 1},
+		},
+	}
+	_, err = commentsCollection.Indexes().CreateOne(ctx, commentsIndex)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	reactionsIndex := mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "target_type", Value: 1},
+			{Key: "target_id", Value: 1},
+			{Key: "user_id", Value: 1},
+			{Key: "type", Value: 1},
+		},
+		Options: options.Index().SetUnique(true),
+	}
+	_, err = reactionsCollection.Indexes().CreateOne(ctx, reactionsIndex)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	votesIndex := mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "post_id", Value: 1},
+			{Key: "user_id", Value: 1},
+		},
+		Options: options.Index().SetUnique(true),
+	}
+	_, err = votesCollection.Indexes().CreateOne(ctx, votesIndex)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	revisionsIndex := mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "post_id", Value: 1},
+			{Key: "created_at", Value: -1},
+		},
+	}
+	_, err = revisionsCollection.Indexes().CreateOne(ctx, revisionsIndex)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	viewsIndex := mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "post_id", Value: 1},
+			{Key: "day", Value: 1},
+			{Key: "user_id", Value: 1},
+		},
+		Options: options.Index().SetUnique(true),
+	}
+	_, err = viewsCollection.Indexes().CreateOne(ctx, viewsIndex)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	viewStatsIndex := mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "post_id", Value: 1},
+			{Key: "day", Value: 1},
+		},
+		Options: options.Index().SetUnique(true),
+	}
+	_, err = viewStatsCollection.Indexes().CreateOne(ctx, viewStatsIndex)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// a user can have only one open report per target
+	reportsIndex := mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "target_type", Value: 1},
+			{Key: "target_id", Value: 1},
+			{Key: "reporter_id", Value: 1},
+		},
+		Options: options.Index().
+			SetUnique(true).
+			SetPartialFilterExpression(bson.M{"status": "OPEN"}),
+	}
+	_, err = reportsCollection.Indexes().CreateOne(ctx, reportsIndex)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	_, err = reportsCollection.Indexes().CreateOne(ctx, mongo.IndexModel{Keys: bson.D{{Key: "status", Value: 1}, {Key: "target_type", Value: 1}}})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// an alias belongs to one tag only, the tags without aliases don't have the field
+	tagAliasesIndex := mongo.IndexModel{
+		Keys: bson.D{{Key: "aliases", Value: 1}},
+		Options: options.Index().
+			SetUnique(true).
+			SetPartialFilterExpression(bson.M{"aliases": bson.M{"$exists": true}}),
+	}
+	_, err = tagsCollection.Indexes().CreateOne(ctx, tagAliasesIndex)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	_, err = tagsCollection.Indexes().CreateOne(ctx, mongo.IndexModel{Keys: bson.D{{Key: "usage_count", Value: -1}}})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
 	return &Storage{
 		client: client,
 		collections: collections{
@@ -84,6 +232,15 @@ func New(ctx context.Context, cfg config.MongoDB) (*Storage, error) {
 			participantsCollection: participantsCollection,
 			bansCollection:         bansCollection,
 			postsCollection:        postsCollection,
+			commentsCollection:     commentsCollection,
+			reactionsCollection:    reactionsCollection,
+			votesCollection:        votesCollection,
+			revisionsCollection:    revisionsCollection,
+			viewsCollection:        viewsCollection,
+			viewStatsCollection:    viewStatsCollection,
+			reportsCollection:      reportsCollection,
+			tagsCollection:         tagsCollection,
+			clubPinsCollection:     clubPinsCollection,
 		},
 	}, nil
 }
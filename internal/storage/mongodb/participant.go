@@ -11,7 +11,7 @@ import (
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
-	"go.mongodb.org/mongo-driver/mongo/options"
+	"regexp"
 	"time"
 )
 
@@ -154,36 +154,23 @@ func (s *Storage) ListParticipants(ctx context.Context, dto *dtos.ListParticipan
 
 	filter := bson.M{"event_id": objectID}
 	if dto.Filter.Query != "" {
-		filter["user.first_name"] = primitive.Regex{Pattern: dto.Filter.Query, Options: "i"}
+		filter["user.first_name"] = primitive.Regex{Pattern: regexp.QuoteMeta(dto.Filter.Query), Options: "i"}
 	}
 
-	totalRecords, err := s.participantsCollection.CountDocuments(ctx, filter)
+	page, err := findPage(ctx, s.participantsCollection, filter, constructEventSortBy(dto.Filter), dto.Filter, nil)
 	if err != nil {
 		return nil, nil, fmt.Errorf("%s: %w", op, err)
 	}
-	if totalRecords == 0 {
+	if page.NoMatches {
 		return nil, &domain.PaginationMetadata{}, nil
 	}
 
-	opts := options.Find()
-	opts.SetSort(constructEventSortBy(dto.Filter))
-	opts.SetSkip(int64(dto.Filter.Offset()))
-	opts.SetLimit(int64(dto.Filter.Limit()))
-
-	cursor, err := s.participantsCollection.Find(ctx, filter, opts)
+	participants, err := decodeDocuments[dao.Participant](page.Documents)
 	if err != nil {
-		return nil, nil, handleError(op, err)
-	}
-	defer cursor.Close(ctx)
-
-	var participants []dao.Participant
-	if err = cursor.All(ctx, &participants); err != nil {
 		return nil, nil, fmt.Errorf("%s: %w", op, err)
 	}
 
-	paginationMetadata := domain.CalculatePaginationMetadata(int32(totalRecords), dto.Filter.Page, dto.Filter.PageSize)
-
-	return dao.ParticipantsToDomain(participants), &paginationMetadata, nil
+	return dao.ParticipantsToDomain(participants), &page.Metadata, nil
 }
 
 func (s *Storage) ListBannedParticipants(ctx context.Context, dto *dtos.ListBans) ([]domain.BanRecord, *domain.PaginationMetadata, error) {
@@ -192,42 +179,30 @@ func (s *Storage) ListBannedParticipants(ctx context.Context, dto *dtos.ListBans
 	objectID, err := primitive.ObjectIDFromHex(dto.EventId)
 	if err != nil {
 		if errors.Is(err, primitive.ErrInvalidHex) {
-			return nil, nil, fmt.Errorf("%s: %w", storage.ErrInvalidID)
+			return nil, nil, fmt.Errorf("%s: %w", op, storage.ErrInvalidID)
 		}
 		return nil, nil, fmt.Errorf("%s: %w", op, err)
 	}
 
 	filter := bson.M{"event_id": objectID}
 	if dto.Filter.Query != "" {
-		filter["user.first_name"] = primitive.Regex{Pattern: dto.Filter.Query, Options: "i"}
+		filter["user.first_name"] = primitive.Regex{Pattern: regexp.QuoteMeta(dto.Filter.Query), Options: "i"}
 	}
 
-	totalRecords, err := s.bansCollection.CountDocuments(ctx, filter)
+	page, err := findPage(ctx, s.bansCollection, filter, bson.D{{Key: "_id", Value: 1}}, dto.Filter, nil)
 	if err != nil {
 		return nil, nil, fmt.Errorf("%s: %w", op, err)
 	}
-	if totalRecords == 0 {
+	if page.NoMatches {
 		return nil, &domain.PaginationMetadata{}, nil
 	}
 
-	opts := options.Find()
-	opts.SetSkip(int64(dto.Filter.Offset()))
-	opts.SetLimit(int64(dto.Filter.Limit()))
-
-	cursor, err := s.bansCollection.Find(ctx, filter, opts)
+	bans, err := decodeDocuments[dao.BanRecord](page.Documents)
 	if err != nil {
-		return nil, nil, handleError(op, err)
-	}
-	defer cursor.Close(ctx)
-
-	var bans []dao.BanRecord
-	if err = cursor.All(ctx, &bans); err != nil {
 		return nil, nil, fmt.Errorf("%s: %w", op, err)
 	}
 
-	paginationMetadata := domain.CalculatePaginationMetadata(int32(totalRecords), dto.Filter.Page, dto.Filter.PageSize)
-
-	return dao.BanRecordsToDomain(bans), &paginationMetadata, nil
+	return dao.BanRecordsToDomain(bans), &page.Metadata, nil
 }
 
 func (s *Storage) PurgeParticipants(ctx context.Context, eventId string) error {
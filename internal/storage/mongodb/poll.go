@@ -0,0 +1,101 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/arumandesu/uniclubs-posts-service/internal/domain"
+	"github.com/arumandesu/uniclubs-posts-service/internal/storage"
+	"github.com/arumandesu/uniclubs-posts-service/internal/storage/mongodb/dao"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// CreateVote stores the vote, the unique index of the votes collection guarantees one vote per user
+func (s *Storage) CreateVote(ctx context.Context, vote *domain.Vote) error {
+	const op = "storage.mongodb.poll.createVote"
+
+	daoVote, err := dao.VoteFromDomain(vote)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, storage.ErrInvalidID)
+	}
+
+	_, err = s.votesCollection.InsertOne(ctx, daoVote)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return fmt.Errorf("%s: %w", op, storage.ErrAlreadyVoted)
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *Storage) GetUserVote(ctx context.Context, postId string, userId int64) (*domain.Vote, error) {
+	const op = "storage.mongodb.poll.getUserVote"
+
+	objectID, err := primitive.ObjectIDFromHex(postId)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, storage.ErrInvalidID)
+	}
+
+	var vote dao.Vote
+	err = s.votesCollection.FindOne(ctx, bson.M{"post_id": objectID, "user_id": userId}).Decode(&vote)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, fmt.Errorf("%s: %w", op, storage.ErrVoteNotFound)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return dao.VoteToDomain(&vote), nil
+}
+
+// GetPollTallies counts the votes of every voted option and the number of voters,
+// the voter ids are collected only when withVoters is set
+func (s *Storage) GetPollTallies(ctx context.Context, postId string, withVoters bool) ([]domain.PollOptionResult, int64, error) {
+	const op = "storage.mongodb.poll.getPollTallies"
+
+	objectID, err := primitive.ObjectIDFromHex(postId)
+	if err != nil {
+		return nil, 0, fmt.Errorf("%s: %w", op, storage.ErrInvalidID)
+	}
+
+	filter := bson.M{"post_id": objectID}
+
+	totalVoters, err := s.votesCollection.CountDocuments(ctx, filter)
+	if err != nil {
+		return nil, 0, fmt.Errorf("%s: %w", op, err)
+	}
+	if totalVoters == 0 {
+		return nil, 0, nil
+	}
+
+	group := bson.M{
+		"_id":   "$option_ids",
+		"votes": bson.M{"$sum": 1},
+	}
+	if withVoters {
+		group["voters"] = bson.M{"$push": "$user_id"}
+	}
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: filter}},
+		{{Key: "$unwind", Value: "$option_ids"}},
+		{{Key: "$group", Value: group}},
+	}
+
+	cursor, err := s.votesCollection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, 0, fmt.Errorf("%s: %w", op, err)
+	}
+	defer cursor.Close(ctx)
+
+	var tallies []dao.PollOptionTally
+	if err = cursor.All(ctx, &tallies); err != nil {
+		return nil, 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return dao.PollOptionTalliesToDomain(tallies), totalVoters, nil
+}
@@ -50,8 +50,8 @@ func (s *Storage) UpdatePost(ctx context.Context, post *domain.Post) (*domain.Po
 	daoPost.UpdatedAt = time.Now()
 
 	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
-	filter := bson.M{"_id": daoPost.ID, "updated_at": lastUpdated}
-	update := bson.M{"$set": daoPost}
+	filter := bson.M{"_id": daoPost.ID, "updated_at": lastUpdated, "deleted_at": nil}
+	update := postUpdate(post, daoPost)
 
 	err := s.postsCollection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&daoPost)
 	if err != nil {
@@ -60,20 +60,62 @@ func (s *Storage) UpdatePost(ctx context.Context, post *domain.Post) (*domain.Po
 		}
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
+	if !post.IsPublished() {
+		if err = s.releasePin(ctx, post.Club.ID, post.ID); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+	}
 
 	return dao.PostToDomain(daoPost), nil
 }
 
-func (s *Storage) DeletePost(ctx context.Context, postId string) (*domain.Post, error) {
+// postUpdate sets the post content, the post which is not published anymore is unpinned so it does not keep the club pin slot
+func postUpdate(post *domain.Post, daoPost *dao.Post) bson.M {
+	update := bson.M{"$set": daoPost}
+	if !post.IsPublished() {
+		update["$unset"] = unpinUpdate["$unset"]
+	}
+	return update
+}
+
+// DeletePost soft deletes the post, it stays in the collection until it is restored or purged
+func (s *Storage) DeletePost(ctx context.Context, postId string, userId int64) (*domain.Post, error) {
 	const op = "storage.mongodb.post.deletePost"
 
+	// the deleted post is unpinned so it does not count towards the club pinned posts limit
+	update := bson.M{
+		"$set":   bson.M{"deleted_at": time.Now(), "deleted_by": userId},
+		"$unset": unpinUpdate["$unset"],
+	}
+
+	post, err := s.findAndUpdatePost(ctx, postId, update)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if err = s.releasePin(ctx, post.Club.ID, postId); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return post, nil
+}
+
+func (s *Storage) RestorePost(ctx context.Context, postId string) (*domain.Post, error) {
+	const op = "storage.mongodb.post.restorePost"
+
 	objectID, err := primitive.ObjectIDFromHex(postId)
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, storage.ErrInvalidID)
 	}
 
+	filter := bson.M{"_id": objectID, "deleted_at": bson.M{"$ne": nil}}
+	update := bson.M{
+		"$set":   bson.M{"updated_at": time.Now()},
+		"$unset": bson.M{"deleted_at": "", "deleted_by": ""},
+	}
+
 	var post dao.Post
-	err = s.postsCollection.FindOneAndDelete(ctx, bson.M{"_id": objectID}).Decode(&post)
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	err = s.postsCollection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&post)
 	if err != nil {
 		if errors.Is(err, mongo.ErrNoDocuments) {
 			return nil, fmt.Errorf("%s: %w", op, storage.ErrNotFound)
@@ -84,73 +126,442 @@ func (s *Storage) DeletePost(ctx context.Context, postId string) (*domain.Post,
 	return dao.PostToDomain(&post), nil
 }
 
-func (s *Storage) HidePost(ctx context.Context, postId string) (*domain.Post, error) {
-	// todo: implement me
-	panic("implement me")
+// PurgeDeletedPosts permanently removes the posts deleted before the given time with their revisions, votes, views, comments and reactions,
+// it returns the number of purged posts
+func (s *Storage) PurgeDeletedPosts(ctx context.Context, before time.Time) (int64, error) {
+	const op = "storage.mongodb.post.purgeDeletedPosts"
+
+	filter := bson.M{"deleted_at": bson.M{"$lte": before}}
+
+	cursor, err := s.postsCollection.Find(ctx, filter, options.Find().SetProjection(bson.M{"_id": 1}))
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	defer cursor.Close(ctx)
+
+	var posts []struct {
+		ID primitive.ObjectID `bson:"_id"`
+	}
+	if err = cursor.All(ctx, &posts); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	if len(posts) == 0 {
+		return 0, nil
+	}
+
+	objectIDs := make([]primitive.ObjectID, len(posts))
+	for i, post := range posts {
+		objectIDs[i] = post.ID
+	}
+
+	// the related documents are removed first, so the failed purge is retried with the next run
+	for _, r := range s.postRelatedDocuments(objectIDs) {
+		if _, err = r.collection.DeleteMany(ctx, r.filter); err != nil {
+			return 0, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	res, err := s.postsCollection.DeleteMany(ctx, bson.M{"_id": bson.M{"$in": objectIDs}, "deleted_at": bson.M{"$lte": before}})
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return res.DeletedCount, nil
+}
+
+// relatedDocuments is the filter of the documents in the collection which belong to the purged posts
+type relatedDocuments struct {
+	collection *mongo.Collection
+	filter     bson.M
+}
+
+// postRelatedDocuments returns the documents of the posts in the other collections,
+// the votes keep the post id as ObjectID, the rest keep it as the hex string
+func (c collections) postRelatedDocuments(objectIDs []primitive.ObjectID) []relatedDocuments {
+	postIds := make([]string, len(objectIDs))
+	for i, id := range objectIDs {
+		postIds[i] = id.Hex()
+	}
+
+	return []relatedDocuments{
+		{c.revisionsCollection, bson.M{"post_id": bson.M{"$in": postIds}}},
+		{c.votesCollection, bson.M{"post_id": bson.M{"$in": objectIDs}}},
+		{c.viewsCollection, bson.M{"post_id": bson.M{"$in": postIds}}},
+		{c.viewStatsCollection, bson.M{"post_id": bson.M{"$in": postIds}}},
+		{c.commentsCollection, bson.M{"target_type": domain.TargetPost.String(), "target_id": bson.M{"$in": postIds}}},
+		{c.reactionsCollection, bson.M{"target_type": domain.TargetPost.String(), "target_id": bson.M{"$in": postIds}}},
+	}
+}
+
+func (s *Storage) HidePost(ctx context.Context, postId string, userId int64) (*domain.Post, error) {
+	const op = "storage.mongodb.post.hidePost"
+
+	update := bson.M{"$set": bson.M{
+		"hidden":    true,
+		"hidden_by": userId,
+		"hidden_at": time.Now(),
+	}}
+
+	post, err := s.findAndUpdatePost(ctx, postId, update)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return post, nil
 }
 
 func (s *Storage) UnhidePost(ctx context.Context, postId string) (*domain.Post, error) {
-	// todo: implement me
-	panic("implement me")
+	const op = "storage.mongodb.post.unhidePost"
+
+	update := bson.M{
+		"$set":   bson.M{"hidden": false},
+		"$unset": bson.M{"hidden_by": "", "hidden_at": ""},
+	}
+
+	post, err := s.findAndUpdatePost(ctx, postId, update)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return post, nil
+}
+
+func (s *Storage) findAndUpdatePost(ctx context.Context, postId string, update bson.M) (*domain.Post, error) {
+	objectID, err := primitive.ObjectIDFromHex(postId)
+	if err != nil {
+		return nil, storage.ErrInvalidID
+	}
+
+	var post dao.Post
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	err = s.postsCollection.FindOneAndUpdate(ctx, bson.M{"_id": objectID, "deleted_at": nil}, update, opts).Decode(&post)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, storage.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return dao.PostToDomain(&post), nil
+}
+
+/*
+PinPost pins the post and takes its place in the club pins document, limit is the number of the club pinned posts.
+
+	The place is taken by a conditional update of the club pins document, so the concurrent pins can't exceed the limit.
+	Pinning the already pinned post replaces its pin, the place is released when the post can't be pinned.
+*/
+func (s *Storage) PinPost(ctx context.Context, clubId int64, postId string, userId int64, expiresAt time.Time, limit int) (*domain.Post, error) {
+	const op = "storage.mongodb.post.pinPost"
+
+	now := time.Now()
+	if err := s.takePin(ctx, clubId, postId, expiresAt, limit, now); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	set := bson.M{
+		"pinned":    true,
+		"pinned_by": userId,
+		"pinned_at": now,
+	}
+	update := bson.M{"$set": set}
+	if expiresAt.IsZero() {
+		update["$unset"] = bson.M{"pin_expires_at": ""}
+	} else {
+		set["pin_expires_at"] = expiresAt
+	}
+
+	post, err := s.findAndUpdatePost(ctx, postId, update)
+	if err != nil {
+		if releaseErr := s.releasePin(ctx, clubId, postId); releaseErr != nil {
+			err = errors.Join(err, releaseErr)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return post, nil
+}
+
+func (s *Storage) UnpinPost(ctx context.Context, postId string) (*domain.Post, error) {
+	const op = "storage.mongodb.post.unpinPost"
+
+	post, err := s.findAndUpdatePost(ctx, postId, unpinUpdate)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if err = s.releasePin(ctx, post.Club.ID, postId); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return post, nil
+}
+
+/*
+takePin adds the pin of the post to the club pins document unless the club already has limit pins of the other posts.
+
+	The document is {_id: club id, pins: [{post_id, expires_at}]}, the pins without expiration have null expires_at.
+	The expired pins are not counted and are dropped by the update, the previous pin of the post is replaced.
+*/
+func (s *Storage) takePin(ctx context.Context, clubId int64, postId string, expiresAt time.Time, limit int, now time.Time) error {
+	upsert := options.Update().SetUpsert(true)
+	_, err := s.clubPinsCollection.UpdateOne(ctx, bson.M{"_id": clubId}, bson.M{"$setOnInsert": bson.M{"pins": bson.A{}}}, upsert)
+	if err != nil {
+		return err
+	}
+
+	// the pins of the other posts which have not expired yet
+	otherPins := bson.M{"$filter": bson.M{
+		"input": "$pins",
+		"as":    "pin",
+		"cond": bson.M{"$and": bson.A{
+			bson.M{"$ne": bson.A{"$$pin.post_id", postId}},
+			bson.M{"$or": bson.A{
+				bson.M{"$eq": bson.A{"$$pin.expires_at", nil}},
+				bson.M{"$gt": bson.A{"$$pin.expires_at", now}},
+			}},
+		}},
+	}}
+
+	pin := bson.M{"post_id": postId, "expires_at": nil}
+	if !expiresAt.IsZero() {
+		pin["expires_at"] = expiresAt
+	}
+
+	filter := bson.M{
+		"_id":   clubId,
+		"$expr": bson.M{"$lt": bson.A{bson.M{"$size": otherPins}, limit}},
+	}
+	update := mongo.Pipeline{
+		{{Key: "$set", Value: bson.M{"pins": bson.M{"$concatArrays": bson.A{otherPins, bson.A{pin}}}}}},
+	}
+
+	res, err := s.clubPinsCollection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return storage.ErrPinLimitReached
+	}
+
+	return nil
+}
+
+// releasePin removes the pin of the post from the club pins document
+func (s *Storage) releasePin(ctx context.Context, clubId int64, postId string) error {
+	_, err := s.clubPinsCollection.UpdateOne(ctx, bson.M{"_id": clubId}, bson.M{"$pull": bson.M{"pins": bson.M{"post_id": postId}}})
+	return err
 }
 
+// UnpinExpiredPosts unpins the posts whose pin expiration time has come, it returns the number of unpinned posts
+func (s *Storage) UnpinExpiredPosts(ctx context.Context, now time.Time) (int64, error) {
+	const op = "storage.mongodb.post.unpinExpiredPosts"
+
+	filter := bson.M{
+		"pinned":         true,
+		"pin_expires_at": bson.M{"$lte": now},
+	}
+
+	res, err := s.postsCollection.UpdateMany(ctx, filter, unpinUpdate)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// the expired pins don't take places already, they are dropped to keep the club pins documents small
+	_, err = s.clubPinsCollection.UpdateMany(ctx, bson.M{}, bson.M{"$pull": bson.M{"pins": bson.M{"expires_at": bson.M{"$lte": now}}}})
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return res.ModifiedCount, nil
+}
+
+var unpinUpdate = bson.M{"$unset": bson.M{"pinned": "", "pinned_by": "", "pinned_at": "", "pin_expires_at": ""}}
+
+// PublishScheduledPosts publishes the scheduled posts whose publish time has come, it returns the published posts
+func (s *Storage) PublishScheduledPosts(ctx context.Context, now time.Time) ([]domain.Post, error) {
+	const op = "storage.mongodb.post.publishScheduledPosts"
+
+	filter := bson.M{
+		"status":     domain.PostStatusScheduled.String(),
+		"publish_at": bson.M{"$lte": now},
+	}
+
+	cursor, err := s.postsCollection.Find(ctx, filter, options.Find().SetProjection(bson.M{"_id": 1}))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer cursor.Close(ctx)
+
+	var due []struct {
+		ID primitive.ObjectID `bson:"_id"`
+	}
+	if err = cursor.All(ctx, &due); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// updated_at is changed to make the concurrent post updates fail the optimistic locking
+	update := bson.M{"$set": bson.M{"status": domain.PostStatusPublished.String(), "updated_at": now}}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+	// every post is published by its own conditional update, so the post rescheduled in the meantime is not returned
+	published := make([]domain.Post, 0, len(due))
+	for _, post := range due {
+		filter["_id"] = post.ID
+
+		var daoPost dao.Post
+		err = s.postsCollection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&daoPost)
+		if err != nil {
+			if errors.Is(err, mongo.ErrNoDocuments) {
+				continue
+			}
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		published = append(published, *dao.PostToDomain(&daoPost))
+	}
+
+	return published, nil
+}
+
+// ExpirePosts marks the published posts whose expiration time has come as expired, it returns the number of expired posts
+func (s *Storage) ExpirePosts(ctx context.Context, now time.Time) (int64, error) {
+	const op = "storage.mongodb.post.expirePosts"
+
+	filter := bson.M{
+		"status":     bson.M{"$in": []interface{}{domain.PostStatusPublished.String(), nil}},
+		"expires_at": bson.M{"$lte": now},
+	}
+
+	cursor, err := s.postsCollection.Find(ctx, filter, options.Find().SetProjection(bson.M{"_id": 1}))
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	defer cursor.Close(ctx)
+
+	var due []struct {
+		ID primitive.ObjectID `bson:"_id"`
+	}
+	if err = cursor.All(ctx, &due); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// every post is expired by its own conditional update, so only the posts which are really expired give their club pin slots back
+	var expired int64
+	for _, post := range due {
+		filter["_id"] = post.ID
+
+		var daoPost dao.Post
+		err = s.postsCollection.FindOneAndUpdate(ctx, filter, expireUpdate(now)).Decode(&daoPost)
+		if err != nil {
+			if errors.Is(err, mongo.ErrNoDocuments) {
+				continue
+			}
+			return expired, fmt.Errorf("%s: %w", op, err)
+		}
+		expired++
+
+		if err = s.releasePin(ctx, daoPost.Club.ID, post.ID.Hex()); err != nil {
+			return expired, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	return expired, nil
+}
+
+// expireUpdate marks the post as expired and unpins it, the expired post is not listed so it can't keep the club pin slot
+func expireUpdate(now time.Time) bson.M {
+	return bson.M{
+		"$set":   bson.M{"status": domain.PostStatusExpired.String(), "updated_at": now},
+		"$unset": unpinUpdate["$unset"],
+	}
+}
+
+// GetPostById returns the post, deleted posts are not returned
 func (s *Storage) GetPostById(ctx context.Context, postId string) (*domain.Post, error) {
 	const op = "storage.mongodb.post.getPostById"
 
+	post, err := s.findPost(ctx, postId, bson.M{"deleted_at": nil})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return post, nil
+}
+
+func (s *Storage) GetDeletedPostById(ctx context.Context, postId string) (*domain.Post, error) {
+	const op = "storage.mongodb.post.getDeletedPostById"
+
+	post, err := s.findPost(ctx, postId, bson.M{"deleted_at": bson.M{"$ne": nil}})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return post, nil
+}
+
+func (s *Storage) findPost(ctx context.Context, postId string, filter bson.M) (*domain.Post, error) {
 	objectID, err := primitive.ObjectIDFromHex(postId)
 	if err != nil {
-		return nil, fmt.Errorf("%s: %w", op, storage.ErrInvalidID)
+		return nil, storage.ErrInvalidID
 	}
+	filter["_id"] = objectID
 
 	var post dao.Post
-	err = s.postsCollection.FindOne(ctx, bson.M{"_id": objectID}).Decode(&post)
+	err = s.postsCollection.FindOne(ctx, filter).Decode(&post)
 	if err != nil {
 		if errors.Is(err, mongo.ErrNoDocuments) {
-			return nil, fmt.Errorf("%s: %w", op, storage.ErrNotFound)
+			return nil, storage.ErrNotFound
 		}
-		return nil, fmt.Errorf("%s: %w", op, err)
+		return nil, err
 	}
 
 	return dao.PostToDomain(&post), nil
 }
 
+// HasEventPost reports if the club already has a post which references the event
+func (s *Storage) HasEventPost(ctx context.Context, eventId string, clubId int64) (bool, error) {
+	const op = "storage.mongodb.post.hasEventPost"
+
+	count, err := s.postsCollection.CountDocuments(ctx, bson.M{"club._id": clubId, "event_ids": eventId}, options.Count().SetLimit(1))
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return count > 0, nil
+}
+
 func (s *Storage) ListPosts(ctx context.Context, filters *dtos.ListPostsRequest) ([]domain.Post, *domain.PaginationMetadata, error) {
 	const op = "storage.mongodb.post.listPosts"
 
 	filter := constructPostFilter(filters)
+	filter["$and"] = []bson.M{constructPostVisibilityFilter(filters)}
 
-	totalRecords, err := s.postsCollection.CountDocuments(ctx, filter)
+	opts := options.Find()
+	if filters.Query != "" {
+		opts.SetProjection(bson.M{"score": bson.M{"$meta": "textScore"}})
+	}
+
+	page, err := findPage(ctx, s.postsCollection, filter, constructPostSortBy(filters), filters.BaseFilter, opts)
 	if err != nil {
 		return nil, nil, fmt.Errorf("%s: %w", op, err)
 	}
-	if totalRecords == 0 {
+	if page.NoMatches {
 		return nil, nil, fmt.Errorf("%s: %w", op, storage.ErrNotFound)
 	}
 
-	opts := options.Find()
-	//todo: make this work later
-	//opts.SetSort(constructEventSortBy(filters.BaseFilter))
-	opts.SetSkip(int64(filters.Offset()))
-	opts.SetLimit(int64(filters.Limit()))
-
-	cursor, err := s.postsCollection.Find(ctx, filter, opts)
+	posts, err := decodeDocuments[dao.Post](page.Documents)
 	if err != nil {
-		return nil, nil, handleError(op, err)
-	}
-	defer cursor.Close(ctx)
-
-	var posts []dao.Post
-	if err = cursor.All(ctx, &posts); err != nil {
 		return nil, nil, fmt.Errorf("%s: %w", op, err)
 	}
 
-	paginationMetadata := domain.CalculatePaginationMetadata(int32(totalRecords), filters.Page, filters.PageSize)
-
-	return dao.PostsToDomain(posts), &paginationMetadata, nil
+	return dao.PostsToDomain(posts), &page.Metadata, nil
 }
 
 func constructPostFilter(filters *dtos.ListPostsRequest) bson.M {
-	m := bson.M{}
+	m := bson.M{"deleted_at": nil}
+
+	if filters.Query != "" {
+		m["$text"] = bson.M{"$search": filters.Query}
+	}
 
 	if filters.ClubId != 0 {
 		m["club._id"] = filters.ClubId
@@ -160,22 +571,120 @@ func constructPostFilter(filters *dtos.ListPostsRequest) bson.M {
 		m["tags"] = bson.M{"$in": filters.Tags}
 	}
 
+	createdAt := bson.M{}
+	if !filters.FromDate.IsZero() {
+		createdAt["$gte"] = filters.FromDate
+	}
+	if !filters.ToDate.IsZero() {
+		createdAt["$lte"] = filters.ToDate
+	}
+	if len(createdAt) > 0 {
+		m["created_at"] = createdAt
+	}
+
+	if !filters.IncludeHidden {
+		m["hidden"] = bson.M{"$ne": true}
+	}
+
+	if !filters.IncludeUnpublished {
+		// posts without status were created before statuses were introduced and are published
+		m["status"] = bson.M{"$nin": []string{
+			domain.PostStatusDraft.String(),
+			domain.PostStatusScheduled.String(),
+			domain.PostStatusExpired.String(),
+		}}
+		// expired posts that are not processed by the worker yet
+		m["$or"] = []bson.M{
+			{"expires_at": nil},
+			{"expires_at": bson.M{"$gt": time.Now()}},
+		}
+	}
+
 	return m
 }
 
-func constructPostSortBy(filter *domain.BaseFilter) bson.M {
-	sortBy := bson.M{}
+// constructPostVisibilityFilter limits the members only and managers only posts to the clubs resolved by the service
+func constructPostVisibilityFilter(filters *dtos.ListPostsRequest) bson.M {
+	// posts without visibility were created before it was introduced and are public
+	visible := []bson.M{{"visibility": bson.M{"$nin": restrictedPostVisibilities}}}
+	if len(filters.MemberClubIds) > 0 {
+		visible = append(visible, bson.M{
+			"visibility": domain.PostVisibilityMembers.String(),
+			"club._id":   bson.M{"$in": filters.MemberClubIds},
+		})
+	}
+	if len(filters.ManagedClubIds) > 0 {
+		visible = append(visible, bson.M{"club._id": bson.M{"$in": filters.ManagedClubIds}})
+	}
 
-	switch filter.SortBy {
-	case domain.SortByDate:
-		sortBy["created_at"] = constructEventSortOrder(filter.SortOrder)
-	case domain.SortByParticipants:
-		sortBy["participants"] = constructEventSortOrder(filter.SortOrder)
-	case domain.SortByType:
-		sortBy["type"] = constructEventSortOrder(filter.SortOrder)
-	default:
-		sortBy["start_date"] = 1
+	return bson.M{"$or": visible}
+}
+
+var restrictedPostVisibilities = []string{domain.PostVisibilityMembers.String(), domain.PostVisibilityManagers.String()}
+
+// GetRestrictedPostsClubIds returns the ids of the clubs which have members only or managers only posts matching the filters
+func (s *Storage) GetRestrictedPostsClubIds(ctx context.Context, filters *dtos.ListPostsRequest) ([]int64, error) {
+	const op = "storage.mongodb.post.getRestrictedPostsClubIds"
+
+	filter := constructPostFilter(filters)
+	filter["visibility"] = bson.M{"$in": restrictedPostVisibilities}
+
+	values, err := s.postsCollection.Distinct(ctx, "club._id", filter)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	clubIds := make([]int64, 0, len(values))
+	for _, value := range values {
+		switch id := value.(type) {
+		case int64:
+			clubIds = append(clubIds, id)
+		case int32:
+			clubIds = append(clubIds, int64(id))
+		}
 	}
 
-	return sortBy
+	return clubIds, nil
+}
+
+/*
+constructPostSortBy returns the sort of the posts list, _id is added as a tie-breaker to keep the pagination stable.
+
+	Relevance sorting works only with a search query, it is the default one when the query is present.
+	Without the query the posts are sorted by the creation date.
+	The club posts list returns the pinned posts first. The expired pins stay on top until the worker unpins them,
+	which lags behind the pin expiration time by at most the worker interval, the posts still report them unpinned.
+*/
+func constructPostSortBy(filters *dtos.ListPostsRequest) bson.D {
+	sort := postSortFields(filters)
+	if filters.ClubId != 0 {
+		sort = append(bson.D{{Key: "pinned", Value: -1}}, sort...)
+	}
+
+	return sort
+}
+
+func postSortFields(filters *dtos.ListPostsRequest) bson.D {
+	order := constructEventSortOrder(filters.SortOrder)
+
+	sortBy := filters.SortBy
+	if sortBy == "" && filters.Query != "" {
+		sortBy = domain.SortByRelevance
+	}
+
+	switch sortBy {
+	case domain.SortByRelevance:
+		if filters.Query == "" {
+			return bson.D{{Key: "created_at", Value: order}, {Key: "_id", Value: order}}
+		}
+		return bson.D{{Key: "score", Value: bson.M{"$meta": "textScore"}}, {Key: "_id", Value: -1}}
+	case domain.SortByUpdatedAt:
+		return bson.D{{Key: "updated_at", Value: order}, {Key: "_id", Value: order}}
+	case domain.SortByTitle:
+		return bson.D{{Key: "title", Value: order}, {Key: "_id", Value: order}}
+	case domain.SortByReactions:
+		return bson.D{{Key: "reactions_count", Value: order}, {Key: "_id", Value: order}}
+	default:
+		return bson.D{{Key: "created_at", Value: order}, {Key: "_id", Value: order}}
+	}
 }
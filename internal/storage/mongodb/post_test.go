@@ -0,0 +1,104 @@
+package mongodb
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/arumandesu/uniclubs-posts-service/internal/domain"
+	"github.com/arumandesu/uniclubs-posts-service/internal/storage/mongodb/dao"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func TestPostRelatedDocuments_IdTypes(t *testing.T) {
+	// the client is not used for any request, it only names the collections
+	client, err := mongo.Connect(context.Background(), options.Client().ApplyURI("mongodb://localhost:27017"))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = client.Disconnect(context.Background()) })
+
+	db := client.Database("test")
+	c := collections{
+		commentsCollection:  db.Collection("comments"),
+		reactionsCollection: db.Collection("reactions"),
+		votesCollection:     db.Collection("votes"),
+		revisionsCollection: db.Collection("post_revisions"),
+		viewsCollection:     db.Collection("post_views"),
+		viewStatsCollection: db.Collection("post_view_stats"),
+	}
+
+	postId := primitive.NewObjectID()
+	// the documents as they are stored, the filter has to match their post id field by type
+	stored := map[string]struct {
+		field    string
+		document any
+	}{
+		"comments":        {"target_id", dao.Comment{TargetId: postId.Hex()}},
+		"reactions":       {"target_id", dao.Reaction{TargetId: postId.Hex()}},
+		"votes":           {"post_id", dao.Vote{PostId: postId}},
+		"post_revisions":  {"post_id", dao.PostRevision{PostId: postId.Hex()}},
+		"post_views":      {"post_id", dao.PostView{PostId: postId.Hex()}},
+		"post_view_stats": {"post_id", dao.PostDailyViews{PostId: postId.Hex()}},
+	}
+
+	related := c.postRelatedDocuments([]primitive.ObjectID{postId})
+	require.Len(t, related, len(stored))
+
+	for _, r := range related {
+		name := r.collection.Name()
+		t.Run(name, func(t *testing.T) {
+			expected, ok := stored[name]
+			require.True(t, ok, "the collection is not pinned")
+
+			document, err := bson.Marshal(expected.document)
+			require.NoError(t, err)
+
+			in, ok := r.filter[expected.field].(bson.M)
+			require.True(t, ok, "the filter must match %s", expected.field)
+			filter, err := bson.Marshal(bson.M{"in": in["$in"]})
+			require.NoError(t, err)
+
+			values, err := bson.Raw(filter).Lookup("in").Array().Values()
+			require.NoError(t, err)
+			require.Len(t, values, 1)
+			assert.True(t, bson.Raw(document).Lookup(expected.field).Equal(values[0]), "the filter must use the stored id type")
+		})
+	}
+}
+
+func TestPostUpdate_UnpinsPostWhichIsNotPublished(t *testing.T) {
+	tests := []struct {
+		status   domain.PostStatus
+		unpinned bool
+	}{
+		{domain.PostStatusPublished, false},
+		{"", false},
+		{domain.PostStatusDraft, true},
+		{domain.PostStatusScheduled, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.status.String(), func(t *testing.T) {
+			post := &domain.Post{ID: primitive.NewObjectID().Hex(), Status: tt.status, Pinned: true}
+			update := postUpdate(post, dao.PostFromDomain(post))
+
+			_, unset := update["$unset"]
+			assert.Equal(t, tt.unpinned, unset)
+			if tt.unpinned {
+				assert.Equal(t, unpinUpdate["$unset"], update["$unset"])
+			}
+		})
+	}
+}
+
+func TestExpireUpdate_UnpinsPost(t *testing.T) {
+	now := time.Now()
+	update := expireUpdate(now)
+
+	assert.Equal(t, bson.M{"status": domain.PostStatusExpired.String(), "updated_at": now}, update["$set"])
+	assert.Equal(t, unpinUpdate["$unset"], update["$unset"])
+}
@@ -0,0 +1,120 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"github.com/arumandesu/uniclubs-posts-service/internal/domain"
+	"github.com/arumandesu/uniclubs-posts-service/internal/storage"
+	"github.com/arumandesu/uniclubs-posts-service/internal/storage/mongodb/dao"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// AddReaction stores the reaction and increments the counters of the target,
+// the unique index of the reactions collection guarantees at most one reaction per type per user
+func (s *Storage) AddReaction(ctx context.Context, reaction *domain.Reaction) error {
+	const op = "storage.mongodb.reaction.addReaction"
+
+	_, err := s.reactionsCollection.InsertOne(ctx, dao.ReactionFromDomain(reaction))
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return fmt.Errorf("%s: %w", op, storage.ErrReactionExists)
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	err = s.incrementReactions(ctx, reaction, 1)
+	if err != nil {
+		// the reaction must not be left without being counted
+		_, _ = s.reactionsCollection.DeleteOne(ctx, reactionFilter(reaction))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *Storage) RemoveReaction(ctx context.Context, reaction *domain.Reaction) error {
+	const op = "storage.mongodb.reaction.removeReaction"
+
+	result, err := s.reactionsCollection.DeleteOne(ctx, reactionFilter(reaction))
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if result.DeletedCount == 0 {
+		return fmt.Errorf("%s: %w", op, storage.ErrReactionNotFound)
+	}
+
+	err = s.incrementReactions(ctx, reaction, -1)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *Storage) GetUserReactions(ctx context.Context, targetType domain.TargetType, targetId string, userId int64) ([]domain.ReactionType, error) {
+	const op = "storage.mongodb.reaction.getUserReactions"
+
+	filter := bson.M{"target_type": targetType.String(), "target_id": targetId, "user_id": userId}
+	cursor, err := s.reactionsCollection.Find(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer cursor.Close(ctx)
+
+	var reactions []dao.Reaction
+	if err = cursor.All(ctx, &reactions); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return dao.ToDomainReactionTypes(reactions), nil
+}
+
+// incrementReactions changes the counters of the target with $inc, so concurrent reactions are never lost
+// and the updated_at field used by the optimistic locking is not touched
+func (s *Storage) incrementReactions(ctx context.Context, reaction *domain.Reaction, delta int64) error {
+	const op = "storage.mongodb.reaction.incrementReactions"
+
+	objectID, err := primitive.ObjectIDFromHex(reaction.TargetId)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, storage.ErrInvalidID)
+	}
+
+	var (
+		collection  *mongo.Collection
+		errNotFound error
+	)
+	switch reaction.TargetType {
+	case domain.TargetPost:
+		collection, errNotFound = s.postsCollection, storage.ErrNotFound
+	case domain.TargetEvent:
+		collection, errNotFound = s.eventsCollection, storage.ErrEventNotFound
+	default:
+		return fmt.Errorf("%s: unknown target type %q", op, reaction.TargetType)
+	}
+
+	result, err := collection.UpdateOne(ctx, bson.M{"_id": objectID}, bson.M{
+		"$inc": bson.M{
+			"reactions." + reaction.Type.String(): delta,
+			"reactions_count":                     delta,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if result.MatchedCount == 0 {
+		return fmt.Errorf("%s: %w", op, errNotFound)
+	}
+
+	return nil
+}
+
+func reactionFilter(reaction *domain.Reaction) bson.M {
+	return bson.M{
+		"target_type": reaction.TargetType.String(),
+		"target_id":   reaction.TargetId,
+		"user_id":     reaction.UserId,
+		"type":        reaction.Type.String(),
+	}
+}
@@ -0,0 +1,93 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"github.com/arumandesu/uniclubs-posts-service/internal/domain"
+	"github.com/arumandesu/uniclubs-posts-service/internal/storage/mongodb/dao"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"time"
+)
+
+// GetAttendedEvents returns the events the user has joined, the latest joined first
+func (s *Storage) GetAttendedEvents(ctx context.Context, userId int64, limit int64) ([]domain.Event, error) {
+	const op = "storage.mongodb.recommendation.getAttendedEvents"
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"user._id": userId}}},
+		{{Key: "$sort", Value: bson.D{{Key: "joined_at", Value: -1}, {Key: "_id", Value: -1}}}},
+		{{Key: "$limit", Value: limit}},
+		{{Key: "$lookup", Value: bson.M{
+			"from":         s.eventsCollection.Name(),
+			"localField":   "event_id",
+			"foreignField": "_id",
+			"as":           "event",
+		}}},
+		{{Key: "$unwind", Value: "$event"}},
+		{{Key: "$replaceRoot", Value: bson.M{"newRoot": "$event"}}},
+	}
+
+	cursor, err := s.participantsCollection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer cursor.Close(ctx)
+
+	var events []dao.Event
+	if err = cursor.All(ctx, &events); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return dao.ToDomainEvents(events), nil
+}
+
+/*
+ListRecommendationCandidates returns the soonest upcoming published events the user can still join,
+the events the user has already joined or is banned from are excluded.
+*/
+func (s *Storage) ListRecommendationCandidates(ctx context.Context, userId int64, now time.Time, limit int64) ([]domain.Event, error) {
+	const op = "storage.mongodb.recommendation.listRecommendationCandidates"
+
+	userRecord := func(collection *mongo.Collection, as string) bson.D {
+		return bson.D{{Key: "$lookup", Value: bson.M{
+			"from": collection.Name(),
+			"let":  bson.M{"event_id": "$_id"},
+			"pipeline": bson.A{
+				bson.M{"$match": bson.M{
+					"user._id": userId,
+					"$expr":    bson.M{"$eq": bson.A{"$event_id", "$$event_id"}},
+				}},
+				bson.M{"$limit": 1},
+				bson.M{"$project": bson.M{"_id": 1}},
+			},
+			"as": as,
+		}}}
+	}
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{
+			"status":     domain.EventStatusInProgress.String(),
+			"start_date": bson.M{"$gt": now},
+		}}},
+		{{Key: "$sort", Value: bson.D{{Key: "start_date", Value: 1}, {Key: "_id", Value: 1}}}},
+		userRecord(s.participantsCollection, "joined"),
+		userRecord(s.bansCollection, "banned"),
+		{{Key: "$match", Value: bson.M{"joined": bson.M{"$size": 0}, "banned": bson.M{"$size": 0}}}},
+		{{Key: "$limit", Value: limit}},
+		{{Key: "$unset", Value: bson.A{"joined", "banned"}}},
+	}
+
+	cursor, err := s.eventsCollection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer cursor.Close(ctx)
+
+	var events []dao.Event
+	if err = cursor.All(ctx, &events); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return dao.ToDomainEvents(events), nil
+}
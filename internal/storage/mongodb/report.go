@@ -0,0 +1,177 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/arumandesu/uniclubs-posts-service/internal/domain"
+	dtos "github.com/arumandesu/uniclubs-posts-service/internal/domain/dto"
+	"github.com/arumandesu/uniclubs-posts-service/internal/storage"
+	"github.com/arumandesu/uniclubs-posts-service/internal/storage/mongodb/dao"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// CreateReport stores the report, the partial unique index of the reports collection
+// guarantees at most one open report per user per target
+func (s *Storage) CreateReport(ctx context.Context, report *domain.Report) (*domain.Report, error) {
+	const op = "storage.mongodb.report.createReport"
+
+	daoReport, err := dao.ReportFromDomain(report)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, storage.ErrInvalidID)
+	}
+
+	_, err = s.reportsCollection.InsertOne(ctx, daoReport)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return nil, fmt.Errorf("%s: %w", op, storage.ErrReportExists)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return dao.ReportToDomain(daoReport), nil
+}
+
+func (s *Storage) GetReportById(ctx context.Context, reportId string) (*domain.Report, error) {
+	const op = "storage.mongodb.report.getReportById"
+
+	objectID, err := primitive.ObjectIDFromHex(reportId)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, storage.ErrInvalidID)
+	}
+
+	var report dao.Report
+	err = s.reportsCollection.FindOne(ctx, bson.M{"_id": objectID}).Decode(&report)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, fmt.Errorf("%s: %w", op, storage.ErrReportNotFound)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return dao.ReportToDomain(&report), nil
+}
+
+// ListReports returns all reports of the target, the latest ones first
+func (s *Storage) ListReports(ctx context.Context, dto *dtos.ListReports) ([]domain.Report, *domain.PaginationMetadata, error) {
+	const op = "storage.mongodb.report.listReports"
+
+	filter := bson.M{"target_type": dto.TargetType.String(), "target_id": dto.TargetId}
+
+	totalRecords, err := s.reportsCollection.CountDocuments(ctx, filter)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if totalRecords == 0 {
+		return nil, &domain.PaginationMetadata{}, nil
+	}
+
+	opts := options.Find()
+	opts.SetSort(bson.D{{Key: "created_at", Value: -1}, {Key: "_id", Value: -1}})
+	opts.SetSkip(int64(dto.Filter.Offset()))
+	opts.SetLimit(int64(dto.Filter.Limit()))
+
+	cursor, err := s.reportsCollection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer cursor.Close(ctx)
+
+	var reports []dao.Report
+	if err = cursor.All(ctx, &reports); err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	paginationMetadata := domain.CalculatePaginationMetadata(int32(totalRecords), dto.Filter.Page, dto.Filter.PageSize)
+
+	return dao.ReportsToDomain(reports), &paginationMetadata, nil
+}
+
+// ListModerationQueue groups the open reports by the target, the most reported targets first
+func (s *Storage) ListModerationQueue(ctx context.Context, dto *dtos.ListModerationQueue) ([]domain.ModerationQueueItem, *domain.PaginationMetadata, error) {
+	const op = "storage.mongodb.report.listModerationQueue"
+
+	match := bson.M{"status": domain.ReportStatusOpen.String()}
+	if dto.TargetType != "" {
+		match["target_type"] = dto.TargetType.String()
+	}
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: match}},
+		{{Key: "$group", Value: bson.M{
+			"_id":               bson.M{"target_type": "$target_type", "target_id": "$target_id"},
+			"reports_count":     bson.M{"$sum": 1},
+			"reasons":           bson.M{"$push": "$reason"},
+			"first_reported_at": bson.M{"$min": "$created_at"},
+			"last_reported_at":  bson.M{"$max": "$created_at"},
+		}}},
+		{{Key: "$facet", Value: bson.M{
+			"total": bson.A{bson.M{"$count": "count"}},
+			"items": bson.A{
+				bson.M{"$sort": bson.D{{Key: "reports_count", Value: -1}, {Key: "last_reported_at", Value: -1}, {Key: "_id", Value: 1}}},
+				bson.M{"$skip": dto.Filter.Offset()},
+				bson.M{"$limit": dto.Filter.Limit()},
+				bson.M{"$project": bson.M{
+					"_id":               0,
+					"target_type":       "$_id.target_type",
+					"target_id":         "$_id.target_id",
+					"reports_count":     1,
+					"reasons":           1,
+					"first_reported_at": 1,
+					"last_reported_at":  1,
+				}},
+			},
+		}}},
+	}
+
+	cursor, err := s.reportsCollection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer cursor.Close(ctx)
+
+	var result []struct {
+		Total []struct {
+			Count int32 `bson:"count"`
+		} `bson:"total"`
+		Items []dao.ModerationQueueItem `bson:"items"`
+	}
+	if err = cursor.All(ctx, &result); err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if len(result) == 0 || len(result[0].Total) == 0 {
+		return nil, &domain.PaginationMetadata{}, nil
+	}
+
+	paginationMetadata := domain.CalculatePaginationMetadata(result[0].Total[0].Count, dto.Filter.Page, dto.Filter.PageSize)
+
+	return dao.ToDomainModerationQueue(result[0].Items), &paginationMetadata, nil
+}
+
+// CloseReports records the moderation action of the closed report against all open reports of its target
+func (s *Storage) CloseReports(ctx context.Context, report *domain.Report) (int64, error) {
+	const op = "storage.mongodb.report.closeReports"
+
+	filter := bson.M{
+		"target_type": report.TargetType.String(),
+		"target_id":   report.TargetId,
+		"status":      domain.ReportStatusOpen.String(),
+	}
+	update := bson.M{"$set": bson.M{
+		"status":       report.Status.String(),
+		"action":       report.Action.String(),
+		"moderator_id": report.ModeratorId,
+		"note":         report.Note,
+		"closed_at":    report.ClosedAt,
+	}}
+
+	res, err := s.reportsCollection.UpdateMany(ctx, filter, update)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return res.ModifiedCount, nil
+}
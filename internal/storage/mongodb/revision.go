@@ -0,0 +1,90 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/arumandesu/uniclubs-posts-service/internal/domain"
+	dtos "github.com/arumandesu/uniclubs-posts-service/internal/domain/dto"
+	"github.com/arumandesu/uniclubs-posts-service/internal/storage"
+	"github.com/arumandesu/uniclubs-posts-service/internal/storage/mongodb/dao"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func (s *Storage) CreatePostRevision(ctx context.Context, revision *domain.PostRevision) error {
+	const op = "storage.mongodb.revision.createPostRevision"
+
+	daoRevision, err := dao.PostRevisionFromDomain(revision)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, storage.ErrInvalidID)
+	}
+
+	_, err = s.revisionsCollection.InsertOne(ctx, daoRevision)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// DeletePostRevision removes the revision of the post update which has failed
+func (s *Storage) DeletePostRevision(ctx context.Context, revisionId string) error {
+	const op = "storage.mongodb.revision.deletePostRevision"
+
+	objectID, err := primitive.ObjectIDFromHex(revisionId)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, storage.ErrInvalidID)
+	}
+
+	_, err = s.revisionsCollection.DeleteOne(ctx, bson.M{"_id": objectID})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *Storage) GetPostRevision(ctx context.Context, revisionId string) (*domain.PostRevision, error) {
+	const op = "storage.mongodb.revision.getPostRevision"
+
+	objectID, err := primitive.ObjectIDFromHex(revisionId)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, storage.ErrInvalidID)
+	}
+
+	var revision dao.PostRevision
+	err = s.revisionsCollection.FindOne(ctx, bson.M{"_id": objectID}).Decode(&revision)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, fmt.Errorf("%s: %w", op, storage.ErrRevisionNotFound)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return dao.PostRevisionToDomain(&revision), nil
+}
+
+// ListPostRevisions returns the post revisions, the latest ones first
+func (s *Storage) ListPostRevisions(ctx context.Context, dto *dtos.ListPostRevisions) ([]domain.PostRevision, *domain.PaginationMetadata, error) {
+	const op = "storage.mongodb.revision.listPostRevisions"
+
+	filter := bson.M{"post_id": dto.PostId}
+	sort := bson.D{{Key: "created_at", Value: -1}, {Key: "_id", Value: -1}}
+
+	page, err := findPage(ctx, s.revisionsCollection, filter, sort, dto.Filter, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if page.NoMatches {
+		return nil, &domain.PaginationMetadata{}, nil
+	}
+
+	revisions, err := decodeDocuments[dao.PostRevision](page.Documents)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return dao.PostRevisionsToDomain(revisions), &page.Metadata, nil
+}
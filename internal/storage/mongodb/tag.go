@@ -0,0 +1,342 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/arumandesu/uniclubs-posts-service/internal/domain"
+	"github.com/arumandesu/uniclubs-posts-service/internal/storage"
+	"github.com/arumandesu/uniclubs-posts-service/internal/storage/mongodb/dao"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"regexp"
+	"slices"
+	"time"
+)
+
+func (s *Storage) GetTag(ctx context.Context, slug string) (*domain.Tag, error) {
+	const op = "storage.mongodb.tag.getTag"
+
+	var tag dao.Tag
+	err := s.tagsCollection.FindOne(ctx, bson.M{"_id": slug}).Decode(&tag)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, fmt.Errorf("%s: %w", op, storage.ErrTagNotFound)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return dao.TagToDomain(&tag), nil
+}
+
+// ResolveTagAliases returns the canonical slugs of the merged tags by their aliases, the slugs without an alias are skipped
+func (s *Storage) ResolveTagAliases(ctx context.Context, slugs []string) (map[string]string, error) {
+	const op = "storage.mongodb.tag.resolveTagAliases"
+
+	canonical, err := s.findTagAliases(ctx, bson.M{"aliases": bson.M{"$in": slugs}})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return canonical, nil
+}
+
+// GetTagAliases returns the canonical slugs of all merged tags by their aliases
+func (s *Storage) GetTagAliases(ctx context.Context) (map[string]string, error) {
+	const op = "storage.mongodb.tag.getTagAliases"
+
+	canonical, err := s.findTagAliases(ctx, bson.M{"aliases.0": bson.M{"$exists": true}})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return canonical, nil
+}
+
+func (s *Storage) findTagAliases(ctx context.Context, filter bson.M) (map[string]string, error) {
+	opts := options.Find().SetProjection(bson.M{"aliases": 1})
+	cursor, err := s.tagsCollection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var tags []dao.Tag
+	if err = cursor.All(ctx, &tags); err != nil {
+		return nil, err
+	}
+
+	canonical := make(map[string]string)
+	for _, tag := range tags {
+		for _, alias := range tag.Aliases {
+			canonical[alias] = tag.Slug
+		}
+	}
+
+	return canonical, nil
+}
+
+// IncrementTagUsage changes the usage counters of the tags, the tags used for the first time are added to the registry
+func (s *Storage) IncrementTagUsage(ctx context.Context, delta map[string]int64, now time.Time) error {
+	const op = "storage.mongodb.tag.incrementTagUsage"
+
+	if len(delta) == 0 {
+		return nil
+	}
+
+	models := make([]mongo.WriteModel, 0, len(delta))
+	for slug, d := range delta {
+		models = append(models, mongo.NewUpdateOneModel().
+			SetFilter(bson.M{"_id": slug}).
+			SetUpdate(bson.M{
+				"$inc":         bson.M{"usage_count": d},
+				"$set":         bson.M{"updated_at": now},
+				"$setOnInsert": bson.M{"name": slug, "created_at": now},
+			}).
+			SetUpsert(d > 0))
+	}
+
+	_, err := s.tagsCollection.BulkWrite(ctx, models, options.BulkWrite().SetOrdered(false))
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// SuggestTags returns the used tags whose slug or alias starts with the prefix, the most used ones first
+func (s *Storage) SuggestTags(ctx context.Context, prefix string, limit int64) ([]domain.Tag, error) {
+	const op = "storage.mongodb.tag.suggestTags"
+
+	pattern := "^" + regexp.QuoteMeta(prefix)
+	filter := bson.M{
+		"usage_count": bson.M{"$gt": 0},
+		"$or": bson.A{
+			bson.M{"_id": bson.M{"$regex": pattern}},
+			bson.M{"aliases": bson.M{"$regex": pattern}},
+		},
+	}
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "usage_count", Value: -1}, {Key: "_id", Value: 1}}).
+		SetLimit(limit)
+
+	cursor, err := s.tagsCollection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer cursor.Close(ctx)
+
+	var tags []dao.Tag
+	if err = cursor.All(ctx, &tags); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return dao.TagsToDomain(tags), nil
+}
+
+/*
+MergeTags merges the source tag into the target one: the source and its aliases become the aliases of the target,
+the events and posts are retagged and the usage of the target is recounted.
+
+	The source is removed from the registry before its aliases are moved, the unique aliases index allows an alias only once,
+	it is inserted back when the aliases can't be moved.
+	The retagged documents get the new updated_at, so the concurrent updates made with the old tags fail the optimistic locking.
+	Once the aliases are moved the source tag resolves to the target, the documents which failed to be retagged
+	are fixed by NormalizeStoredTags.
+*/
+func (s *Storage) MergeTags(ctx context.Context, source, target string, now time.Time) (*domain.Tag, error) {
+	const op = "storage.mongodb.tag.mergeTags"
+
+	var sourceTag dao.Tag
+	err := s.tagsCollection.FindOneAndDelete(ctx, bson.M{"_id": source}).Decode(&sourceTag)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, fmt.Errorf("%s: %w", op, storage.ErrTagNotFound)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	aliases := append([]string{source}, sourceTag.Aliases...)
+	_, err = s.tagsCollection.UpdateOne(ctx, bson.M{"_id": target}, bson.M{
+		"$addToSet":    bson.M{"aliases": bson.M{"$each": aliases}},
+		"$set":         bson.M{"updated_at": now},
+		"$setOnInsert": bson.M{"name": target, "created_at": now},
+	}, options.Update().SetUpsert(true))
+	if err != nil {
+		if _, rollbackErr := s.tagsCollection.InsertOne(ctx, sourceTag); rollbackErr != nil {
+			return nil, fmt.Errorf("%s: %w", op, errors.Join(err, rollbackErr))
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	for _, collection := range []*mongo.Collection{s.eventsCollection, s.postsCollection} {
+		// $addToSet and $pull can't change the same field in one update
+		_, err = collection.UpdateMany(ctx, bson.M{"tags": source}, bson.M{
+			"$addToSet": bson.M{"tags": target},
+			"$set":      bson.M{"updated_at": now},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		_, err = collection.UpdateMany(ctx, bson.M{"tags": source}, bson.M{
+			"$pull": bson.M{"tags": source},
+			"$set":  bson.M{"updated_at": now},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	counts, err := s.countTagUsage(ctx, bson.M{"tags": target})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var tag dao.Tag
+	err = s.tagsCollection.FindOneAndUpdate(ctx, bson.M{"_id": target},
+		bson.M{"$set": bson.M{"usage_count": counts[target]}},
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(&tag)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return dao.TagToDomain(&tag), nil
+}
+
+/*
+NormalizeStoredTags rewrites the tags of the events and posts stored before the tags were normalized
+and the tags merged after the documents were saved, it returns the number of changed documents.
+
+	Every document is updated with the optimistic locking, the document changed in the meantime is left for the next run.
+*/
+func (s *Storage) NormalizeStoredTags(ctx context.Context, canonical map[string]string, now time.Time) (int64, error) {
+	const op = "storage.mongodb.tag.normalizeStoredTags"
+
+	var changed int64
+	for _, collection := range []*mongo.Collection{s.eventsCollection, s.postsCollection} {
+		opts := options.Find().SetProjection(bson.M{"tags": 1, "updated_at": 1})
+		cursor, err := collection.Find(ctx, bson.M{"tags.0": bson.M{"$exists": true}}, opts)
+		if err != nil {
+			return changed, fmt.Errorf("%s: %w", op, err)
+		}
+
+		for cursor.Next(ctx) {
+			var document struct {
+				ID        any       `bson:"_id"`
+				Tags      []string  `bson:"tags"`
+				UpdatedAt time.Time `bson:"updated_at"`
+			}
+			if err = cursor.Decode(&document); err != nil {
+				cursor.Close(ctx)
+				return changed, fmt.Errorf("%s: %w", op, err)
+			}
+
+			tags := domain.CanonicalTags(document.Tags, canonical)
+			if slices.Equal(tags, document.Tags) {
+				continue
+			}
+
+			res, err := collection.UpdateOne(ctx,
+				bson.M{"_id": document.ID, "updated_at": document.UpdatedAt},
+				bson.M{"$set": bson.M{"tags": tags, "updated_at": now}},
+			)
+			if err != nil {
+				cursor.Close(ctx)
+				return changed, fmt.Errorf("%s: %w", op, err)
+			}
+			changed += res.ModifiedCount
+		}
+
+		err = cursor.Err()
+		cursor.Close(ctx)
+		if err != nil {
+			return changed, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	return changed, nil
+}
+
+/*
+RecountTagUsage sets the usage counters of the registry to the number of the events and the not deleted posts with the tag,
+the tags which are not used anymore are set to zero and the used tags missing from the registry are added.
+
+	The counters changed concurrently by IncrementTagUsage during the recount may be off until the next recount.
+*/
+func (s *Storage) RecountTagUsage(ctx context.Context, now time.Time) error {
+	const op = "storage.mongodb.tag.recountTagUsage"
+
+	counts, err := s.countTagUsage(ctx, bson.M{"tags.0": bson.M{"$exists": true}})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	slugs := make([]string, 0, len(counts))
+	models := make([]mongo.WriteModel, 0, len(counts))
+	for slug, count := range counts {
+		slugs = append(slugs, slug)
+		models = append(models, mongo.NewUpdateOneModel().
+			SetFilter(bson.M{"_id": slug}).
+			SetUpdate(bson.M{
+				"$set":         bson.M{"usage_count": count, "updated_at": now},
+				"$setOnInsert": bson.M{"name": slug, "created_at": now},
+			}).
+			SetUpsert(true))
+	}
+
+	if len(models) > 0 {
+		_, err = s.tagsCollection.BulkWrite(ctx, models, options.BulkWrite().SetOrdered(false))
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	_, err = s.tagsCollection.UpdateMany(ctx,
+		bson.M{"_id": bson.M{"$nin": slugs}, "usage_count": bson.M{"$ne": 0}},
+		bson.M{"$set": bson.M{"usage_count": 0, "updated_at": now}},
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// countTagUsage counts the events and the not deleted posts matching the filter by their tags,
+// the deleted posts are not counted, the same as TrackUsage does when the post is deleted
+func (s *Storage) countTagUsage(ctx context.Context, filter bson.M) (map[string]int64, error) {
+	postsFilter := bson.M{"deleted_at": nil}
+	for key, value := range filter {
+		postsFilter[key] = value
+	}
+
+	counts := make(map[string]int64)
+	for collection, match := range map[*mongo.Collection]bson.M{s.eventsCollection: filter, s.postsCollection: postsFilter} {
+		cursor, err := collection.Aggregate(ctx, mongo.Pipeline{
+			{{Key: "$match", Value: match}},
+			{{Key: "$unwind", Value: "$tags"}},
+			{{Key: "$group", Value: bson.M{"_id": "$tags", "count": bson.M{"$sum": 1}}}},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		var usage []struct {
+			Slug  string `bson:"_id"`
+			Count int64  `bson:"count"`
+		}
+		err = cursor.All(ctx, &usage)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, u := range usage {
+			counts[u.Slug] += u.Count
+		}
+	}
+
+	return counts, nil
+}
@@ -17,10 +17,11 @@ func (s *Storage) UpdateUser(ctx context.Context, user *domain.User) error {
 		s.updateUserInInviteCollection,
 		s.updateUserInParticipantCollection,
 		s.updateUserInBansCollection,
+		s.updateUserInCommentsCollection,
 	}
 
 	var wg sync.WaitGroup
-	errChan := make(chan error, 2)
+	errChan := make(chan error, len(updateFuncs))
 
 	for _, updateFunc := range updateFuncs {
 		wg.Add(1)
@@ -32,7 +33,7 @@ func (s *Storage) UpdateUser(ctx context.Context, user *domain.User) error {
 		}(updateFunc)
 	}
 
-	// Wait for both updates to complete
+	// Wait for all updates to complete
 	wg.Wait()
 	close(errChan)
 
@@ -0,0 +1,90 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"github.com/arumandesu/uniclubs-posts-service/internal/domain"
+	"github.com/arumandesu/uniclubs-posts-service/internal/storage/mongodb/dao"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"time"
+)
+
+/*
+RecordPostView counts the view of the post in the daily counters of the post.
+
+	The views of the user are counted once per day, the repeated ones are ignored.
+	The anonymous views can't be deduplicated, each of them is counted.
+*/
+func (s *Storage) RecordPostView(ctx context.Context, view *domain.PostView) error {
+	const op = "storage.mongodb.view.recordPostView"
+
+	inc := bson.M{"sources." + view.Source.String(): 1}
+	if view.UserId != 0 {
+		_, err := s.viewsCollection.InsertOne(ctx, dao.PostViewFromDomain(view))
+		if mongo.IsDuplicateKeyError(err) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		inc["views"] = 1
+	} else {
+		inc["anonymous_views"] = 1
+	}
+
+	filter := bson.M{"post_id": view.PostId, "day": view.Day()}
+	_, err := s.viewStatsCollection.UpdateOne(ctx, filter, bson.M{"$inc": inc}, options.Update().SetUpsert(true))
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// GetPostStats returns the views of the post during the days from the day of from to the day of to inclusive
+func (s *Storage) GetPostStats(ctx context.Context, postId string, from, to time.Time) (*domain.PostStats, error) {
+	const op = "storage.mongodb.view.getPostStats"
+
+	days := bson.M{"$gte": domain.ViewDay(from), "$lte": domain.ViewDay(to)}
+
+	cursor, err := s.viewStatsCollection.Find(ctx,
+		bson.M{"post_id": postId, "day": days},
+		options.Find().SetSort(bson.D{{Key: "day", Value: 1}}),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer cursor.Close(ctx)
+
+	var daily []dao.PostDailyViews
+	if err = cursor.All(ctx, &daily); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"post_id": postId, "day": days}}},
+		{{Key: "$group", Value: bson.M{"_id": "$user_id"}}},
+		{{Key: "$count", Value: "viewers"}},
+	}
+	viewersCursor, err := s.viewsCollection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer viewersCursor.Close(ctx)
+
+	var viewers []struct {
+		Viewers int64 `bson:"viewers"`
+	}
+	if err = viewersCursor.All(ctx, &viewers); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var uniqueViewers int64
+	if len(viewers) > 0 {
+		uniqueViewers = viewers[0].Viewers
+	}
+
+	return domain.NewPostStats(postId, from, to, dao.ToDomainDailyViews(daily), dao.SumViewSources(daily), uniqueViewers), nil
+}
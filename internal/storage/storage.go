@@ -14,4 +14,15 @@ var (
 	ErrParticipantNotFound     = errors.New("participant not found")
 	ErrBanRecordNotFound       = errors.New("ban record not found")
 	ErrNotFound                = errors.New("not found")
+	ErrCommentNotFound         = errors.New("comment not found")
+	ErrReactionExists          = errors.New("reaction already exists")
+	ErrReactionNotFound        = errors.New("reaction not found")
+	ErrAlreadyVoted            = errors.New("user already voted")
+	ErrVoteNotFound            = errors.New("vote not found")
+	ErrRevisionNotFound        = errors.New("revision not found")
+	ErrReportExists            = errors.New("report already exists")
+	ErrReportNotFound          = errors.New("report not found")
+	ErrInvalidCursor           = errors.New("invalid pagination cursor")
+	ErrTagNotFound             = errors.New("tag not found")
+	ErrPinLimitReached         = errors.New("pinned posts limit reached")
 )
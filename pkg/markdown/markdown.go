@@ -0,0 +1,63 @@
+// Package markdown renders the user provided markdown into the sanitized HTML and the plain text excerpts
+package markdown
+
+import (
+	"bytes"
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/extension"
+	"html"
+	"regexp"
+	"strings"
+)
+
+var (
+	// raw HTML in the source is escaped by goldmark, the policy is the second line of defense
+	renderer = goldmark.New(goldmark.WithExtensions(extension.GFM))
+	policy   = newPolicy()
+	// textPolicy strips all the tags, it is used to build the excerpts
+	textPolicy = bluemonday.StrictPolicy()
+	// blockEnd matches the tags which separate the text blocks, they are replaced with spaces so the words are not glued
+	blockEnd = regexp.MustCompile(`(?i)</(p|li|h[1-6]|blockquote|pre|td|th|tr)>|<br\s*/?>`)
+)
+
+func newPolicy() *bluemonday.Policy {
+	p := bluemonday.UGCPolicy()
+	p.RequireNoFollowOnLinks(true)
+	p.RequireNoReferrerOnLinks(true)
+	p.AddTargetBlankToFullyQualifiedLinks(true)
+	return p
+}
+
+// Render converts the markdown source into HTML sanitized with the allowlist of tags, links get rel="nofollow"
+func Render(source string) (string, error) {
+	if strings.TrimSpace(source) == "" {
+		return "", nil
+	}
+
+	var buf bytes.Buffer
+	if err := renderer.Convert([]byte(source), &buf); err != nil {
+		return "", err
+	}
+
+	return policy.Sanitize(buf.String()), nil
+}
+
+// Excerpt returns the plain text of the rendered HTML, it is cut on the word boundary when it is longer than maxLength characters
+func Excerpt(renderedHTML string, maxLength int) string {
+	text := blockEnd.ReplaceAllString(renderedHTML, " ")
+	text = html.UnescapeString(textPolicy.Sanitize(text))
+	text = strings.Join(strings.Fields(text), " ")
+
+	runes := []rune(text)
+	if len(runes) <= maxLength {
+		return text
+	}
+
+	cut := string(runes[:maxLength])
+	if i := strings.LastIndexByte(cut, ' '); i > 0 {
+		cut = cut[:i]
+	}
+
+	return strings.TrimRight(cut, " .,;:-") + "…"
+}
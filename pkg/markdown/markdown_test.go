@@ -0,0 +1,64 @@
+package markdown
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRender(t *testing.T) {
+	tests := []struct {
+		name     string
+		source   string
+		contains []string
+		excludes []string
+	}{
+		{
+			name:     "formatting",
+			source:   "# Title\n\nSome **bold** and *italic* text\n\n- one\n- two",
+			contains: []string{"<h1", "<strong>bold</strong>", "<em>italic</em>", "<li>one</li>"},
+		},
+		{
+			name:     "links are nofollow",
+			source:   "[site](https://example.com)",
+			contains: []string{`href="https://example.com"`, `rel="nofollow noreferrer noopener"`},
+		},
+		{
+			name:     "raw html is not rendered",
+			source:   "<script>alert(1)</script>\n\n<img src=x onerror=alert(1)>",
+			excludes: []string{"<script", "onerror", "<img"},
+		},
+		{
+			name:     "javascript links are removed",
+			source:   "[click](javascript:alert(1))",
+			excludes: []string{"javascript:"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rendered, err := Render(tt.source)
+			require.NoError(t, err)
+			for _, s := range tt.contains {
+				assert.Contains(t, rendered, s)
+			}
+			for _, s := range tt.excludes {
+				assert.NotContains(t, rendered, s)
+			}
+		})
+	}
+
+	rendered, err := Render("  \n ")
+	require.NoError(t, err)
+	assert.Empty(t, rendered)
+}
+
+func TestExcerpt(t *testing.T) {
+	rendered, err := Render("# Title\n\nFirst paragraph with **bold** & more.\n\n- one\n- two")
+	require.NoError(t, err)
+
+	assert.Equal(t, "Title First paragraph with bold & more. one two", Excerpt(rendered, 100))
+	assert.Equal(t, "Title First…", Excerpt(rendered, 14))
+	assert.Equal(t, "", Excerpt("", 10))
+}
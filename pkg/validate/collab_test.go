@@ -3,6 +3,7 @@ package validate
 import (
 	"testing"
 
+	posts "github.com/ARUMANDESU/uniclubs-protos/gen/go/posts"
 	eventv1 "github.com/ARUMANDESU/uniclubs-protos/gen/go/posts/event"
 	"github.com/stretchr/testify/assert"
 )
@@ -12,7 +13,7 @@ func TestAddCollaborator(t *testing.T) {
 		req := &eventv1.AddCollaboratorRequest{
 			EventId: "1",
 			UserId:  1,
-			Club:    &eventv1.ClubObject{Id: 1, Name: "Test Club", LogoUrl: "http://example.com/logo.jpg"},
+			Club:    &posts.ClubObject{Id: 1, Name: "Test Club", LogoUrl: "http://example.com/logo.jpg"},
 		}
 
 		err := AddCollaborator(req)
@@ -22,7 +23,7 @@ func TestAddCollaborator(t *testing.T) {
 	t.Run("InvalidRequestMissingEventId", func(t *testing.T) {
 		req := &eventv1.AddCollaboratorRequest{
 			UserId: 1,
-			Club:   &eventv1.ClubObject{Id: 1, Name: "Test Club", LogoUrl: "http://example.com/logo.jpg"},
+			Club:   &posts.ClubObject{Id: 1, Name: "Test Club", LogoUrl: "http://example.com/logo.jpg"},
 		}
 
 		err := AddCollaborator(req)
@@ -32,7 +33,7 @@ func TestAddCollaborator(t *testing.T) {
 	t.Run("InvalidRequestMissingUserId", func(t *testing.T) {
 		req := &eventv1.AddCollaboratorRequest{
 			EventId: "1",
-			Club:    &eventv1.ClubObject{Id: 1, Name: "Test Club", LogoUrl: "http://example.com/logo.jpg"},
+			Club:    &posts.ClubObject{Id: 1, Name: "Test Club", LogoUrl: "http://example.com/logo.jpg"},
 		}
 
 		err := AddCollaborator(req)
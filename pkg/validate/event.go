@@ -95,7 +95,7 @@ func ListEvents(value interface{}) error {
 		return validation.NewInternalError(errors.New("list events invalid type"))
 	}
 
-	validSortBy := []any{domain.SortByDate.String(), domain.SortByParticipants.String(), domain.SortByType.String()}
+	validSortBy := []any{domain.SortByDate.String(), domain.SortByParticipants.String(), domain.SortByType.String(), domain.SortByReactions.String(), domain.SortByRelevance.String()}
 
 	return validation.ValidateStruct(req,
 		validation.Field(&req.Query, validation.Length(0, 1000)),
@@ -1,6 +1,7 @@
 package validate
 
 import (
+	posts "github.com/ARUMANDESU/uniclubs-protos/gen/go/posts"
 	eventv1 "github.com/ARUMANDESU/uniclubs-protos/gen/go/posts/event"
 	"github.com/arumandesu/uniclubs-posts-service/internal/domain"
 	"github.com/brianvoe/gofakeit/v7"
@@ -109,7 +110,7 @@ func TestUpdateEvent_HappyPath(t *testing.T) {
 		EndDate:            time.Now().AddDate(0, 1, 0).Format(domain.TimeLayout),
 		LocationLink:       gofakeit.URL(),
 		LocationUniversity: "Test University",
-		CoverImages: []*eventv1.CoverImage{
+		CoverImages: []*posts.CoverImage{
 			{
 				Url:      gofakeit.URL(),
 				Name:     gofakeit.ProductName(),
@@ -123,7 +124,7 @@ func TestUpdateEvent_HappyPath(t *testing.T) {
 				Position: 2,
 			},
 		},
-		AttachedImages: []*eventv1.FileObject{
+		AttachedImages: []*posts.FileObject{
 			{
 				Url:  gofakeit.URL(),
 				Name: gofakeit.ProductName(),
@@ -135,7 +136,7 @@ func TestUpdateEvent_HappyPath(t *testing.T) {
 				Type: "image/jpeg",
 			},
 		},
-		AttachedFiles: []*eventv1.FileObject{
+		AttachedFiles: []*posts.FileObject{
 			{
 				Url:  gofakeit.URL(),
 				Name: gofakeit.ProductName(),
@@ -641,6 +642,7 @@ func TestSendToReview_HappyPath(t *testing.T) {
 			name: "ValidEvent",
 			event: &domain.Event{
 				Title:       "Test Title",
+				Description: "Test Description",
 				Type:        domain.EventTypeUniversity,
 				StartDate:   time.Now(),
 				EndDate:     time.Now().Add(24 * time.Hour),
@@ -86,7 +86,7 @@ func ListPostsRequest(value interface{}) error {
 		validation.Field(&req.Page, validation.Min(0)),
 		validation.Field(&req.PageSize, validation.Min(0)),
 		validation.Field(&req.Query, validation.Length(0, 255)),
-		validation.Field(&req.SortBy, validation.In("created_at", "title")),
+		validation.Field(&req.SortBy, validation.In("created_at", "updated_at", "title", "relevance", "reactions")),
 		validation.Field(&req.SortOrder, validation.In("asc", "desc")),
 		validation.Field(&req.ClubId, validation.Min(0)),
 		validation.Field(&req.Tags, validation.Length(0, MaxPostTagsCount)),
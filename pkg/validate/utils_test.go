@@ -1,6 +1,7 @@
 package validate
 
 import (
+	posts "github.com/ARUMANDESU/uniclubs-protos/gen/go/posts"
 	eventv1 "github.com/ARUMANDESU/uniclubs-protos/gen/go/posts/event"
 	"github.com/arumandesu/uniclubs-posts-service/internal/domain"
 	"github.com/brianvoe/gofakeit/v7"
@@ -173,18 +174,18 @@ func TestUser_Invalid(t *testing.T) {
 func TestClub_ValidRequest(t *testing.T) {
 	tests := []struct {
 		name string
-		req  *eventv1.ClubObject
+		req  *posts.ClubObject
 	}{
 		{
 			name: "Valid Request without LogoUrl",
-			req: &eventv1.ClubObject{
+			req: &posts.ClubObject{
 				Id:   1,
 				Name: "Test Club",
 			},
 		},
 		{
 			name: "Valid request",
-			req: &eventv1.ClubObject{
+			req: &posts.ClubObject{
 				Id:      1,
 				Name:    "Test Club",
 				LogoUrl: "https://test.com/logo.jpg",
@@ -203,30 +204,30 @@ func TestClub_ValidRequest(t *testing.T) {
 func TestClub_InvalidRequest(t *testing.T) {
 	tests := []struct {
 		name string
-		req  *eventv1.ClubObject
+		req  *posts.ClubObject
 	}{
 		{
 			name: "Empty Name",
-			req: &eventv1.ClubObject{
+			req: &posts.ClubObject{
 				Id: 1,
 			},
 		},
 		{
 			name: "Invalid ID",
-			req: &eventv1.ClubObject{
+			req: &posts.ClubObject{
 				Id:   -1,
 				Name: "Test Club",
 			},
 		},
 		{
 			name: "Empty ID",
-			req: &eventv1.ClubObject{
+			req: &posts.ClubObject{
 				Name: "Test Club",
 			},
 		},
 		{
 			name: "Empty Name and ID",
-			req:  &eventv1.ClubObject{},
+			req:  &posts.ClubObject{},
 		},
 	}
 
@@ -241,11 +242,11 @@ func TestClub_InvalidRequest(t *testing.T) {
 func TestAttachedFiles_ValidRequest(t *testing.T) {
 	tests := []struct {
 		name string
-		req  []*eventv1.FileObject
+		req  []*posts.FileObject
 	}{
 		{
 			name: "With two files",
-			req: []*eventv1.FileObject{
+			req: []*posts.FileObject{
 				{
 					Url:  "https://test.com/file1.pdf",
 					Name: "file1.pdf",
@@ -260,7 +261,7 @@ func TestAttachedFiles_ValidRequest(t *testing.T) {
 		},
 		{
 			name: "With one file",
-			req: []*eventv1.FileObject{
+			req: []*posts.FileObject{
 				{
 					Url:  gofakeit.URL(),
 					Name: "file1.pdf",
@@ -270,7 +271,7 @@ func TestAttachedFiles_ValidRequest(t *testing.T) {
 		},
 		{
 			name: "no files",
-			req:  []*eventv1.FileObject{},
+			req:  []*posts.FileObject{},
 		},
 	}
 
@@ -290,7 +291,7 @@ func TestAttachedFiles_InvalidRequest(t *testing.T) {
 	}{
 		{
 			name: "With invalid type",
-			req: []*eventv1.CoverImage{
+			req: []*posts.CoverImage{
 				{
 					Url:  gofakeit.URL(),
 					Name: gofakeit.ProductName(),
@@ -305,7 +306,7 @@ func TestAttachedFiles_InvalidRequest(t *testing.T) {
 		},
 		{
 			name: "With invalid URL",
-			req: []*eventv1.FileObject{
+			req: []*posts.FileObject{
 				{
 					Url:  "invalid",
 					Name: gofakeit.ProductName(),
@@ -315,7 +316,7 @@ func TestAttachedFiles_InvalidRequest(t *testing.T) {
 		},
 		{
 			name: "Name is empty",
-			req: []*eventv1.FileObject{
+			req: []*posts.FileObject{
 				{
 					Url:  gofakeit.URL(),
 					Name: "",
@@ -325,7 +326,7 @@ func TestAttachedFiles_InvalidRequest(t *testing.T) {
 		},
 		{
 			name: "type is empty",
-			req: []*eventv1.FileObject{
+			req: []*posts.FileObject{
 				{
 					Url:  gofakeit.URL(),
 					Name: gofakeit.ProductName(),
@@ -346,11 +347,11 @@ func TestAttachedFiles_InvalidRequest(t *testing.T) {
 func TestCoverImages_ValidRequest(t *testing.T) {
 	tests := []struct {
 		name string
-		req  []*eventv1.CoverImage
+		req  []*posts.CoverImage
 	}{
 		{
 			name: "With two images",
-			req: []*eventv1.CoverImage{
+			req: []*posts.CoverImage{
 				{
 					Url:      gofakeit.URL(),
 					Name:     gofakeit.ProductName(),
@@ -367,7 +368,7 @@ func TestCoverImages_ValidRequest(t *testing.T) {
 		},
 		{
 			name: "With one image",
-			req: []*eventv1.CoverImage{
+			req: []*posts.CoverImage{
 				{
 					Url:      gofakeit.URL(),
 					Name:     gofakeit.ProductName(),
@@ -378,7 +379,7 @@ func TestCoverImages_ValidRequest(t *testing.T) {
 		},
 		{
 			name: "no images",
-			req:  []*eventv1.CoverImage{},
+			req:  []*posts.CoverImage{},
 		},
 	}
 
@@ -397,7 +398,7 @@ func TestCoverImages_InvalidRequest(t *testing.T) {
 	}{
 		{
 			name: "With invalid type",
-			req: []*eventv1.FileObject{
+			req: []*posts.FileObject{
 				{
 					Url:  gofakeit.URL(),
 					Name: gofakeit.ProductName(),
@@ -412,7 +413,7 @@ func TestCoverImages_InvalidRequest(t *testing.T) {
 		},
 		{
 			name: "With invalid URL",
-			req: []*eventv1.CoverImage{
+			req: []*posts.CoverImage{
 				{
 					Url:      "invalid",
 					Name:     gofakeit.ProductName(),
@@ -423,7 +424,7 @@ func TestCoverImages_InvalidRequest(t *testing.T) {
 		},
 		{
 			name: "Name is empty",
-			req: []*eventv1.CoverImage{
+			req: []*posts.CoverImage{
 				{
 					Url:      gofakeit.URL(),
 					Type:     "image/jpeg",
@@ -433,7 +434,7 @@ func TestCoverImages_InvalidRequest(t *testing.T) {
 		},
 		{
 			name: "type is empty",
-			req: []*eventv1.CoverImage{
+			req: []*posts.CoverImage{
 				{
 					Url:      gofakeit.URL(),
 					Name:     gofakeit.ProductName(),
@@ -443,7 +444,7 @@ func TestCoverImages_InvalidRequest(t *testing.T) {
 		},
 		{
 			name: "Position is empty",
-			req: []*eventv1.CoverImage{
+			req: []*posts.CoverImage{
 				{
 					Url:  gofakeit.URL(),
 					Name: gofakeit.ProductName(),
@@ -606,9 +607,9 @@ func newUser(t *testing.T) *eventv1.UserObject {
 	}
 }
 
-func newClub(t *testing.T) *eventv1.ClubObject {
+func newClub(t *testing.T) *posts.ClubObject {
 	t.Helper()
-	return &eventv1.ClubObject{
+	return &posts.ClubObject{
 		Id:      1,
 		Name:    "Test Club",
 		LogoUrl: "https://test.com/logo.jpg",